@@ -1,6 +1,6 @@
 package main
 
-import ()
+import "math"
 
 //ApprovalMethod is a type of election method that can be used through the Method interface
 type ApprovalMethod struct {
@@ -8,8 +8,17 @@ type ApprovalMethod struct {
 	Winner     int              //index of winning candidate
 	Ballots    []ApprovalBallot //slice containing all ballots
 	Utility    float64          //average utility per voter achieved by winning candidate
+	Threshold  string           //policy used by honest voters to decide which candidates to approve. Empty is thresholdFixed
+	TieBreak   TieBreak         //rule for choosing between candidates with the most approvals
 }
 
+//policies for how an honest voter decides which candidates are good enough to approve
+const (
+	thresholdFixed    = "fixed"    //approve candidates above the voter's ApprovalThreshold
+	thresholdMean     = "mean"     //approve candidates above the average utility of all candidates for the voter
+	thresholdMidrange = "midrange" //approve candidates above the midpoint of the voter's favorite and least favorite
+)
+
 //Create creates the struct members needed to run the election
 func (m *ApprovalMethod) Create(e *Electorate) {
 	m.Ballots = make([]ApprovalBallot, len(e.Voters))
@@ -48,14 +57,7 @@ func (m *ApprovalMethod) Run() {
 		}
 	}
 
-	winningVotes := 0
-
-	for i := range votes {
-		if votes[i] > winningVotes {
-			winningVotes = votes[i]
-			m.Winner = i
-		}
-	}
+	m.Winner = m.TieBreak.pickLargest(toFloats(votes), m.Electorate)
 
 	m.calcUtility()
 
@@ -75,9 +77,10 @@ func (m *ApprovalMethod) calcUtility() {
 //Vote creates a ballot for an honest voter
 func (m *ApprovalMethod) Vote(v *Voter) ApprovalBallot {
 	ballot := ApprovalBallot{Approvals: make([]bool, len(v.Utilities))}
+	threshold := m.threshold(v)
 
 	for i, u := range v.Utilities {
-		if u > v.ApprovalThreshold {
+		if u > threshold {
 			ballot.Approvals[i] = true
 		} else {
 			ballot.Approvals[i] = false
@@ -88,6 +91,29 @@ func (m *ApprovalMethod) Vote(v *Voter) ApprovalBallot {
 
 }
 
+//threshold finds the utility a candidate must beat to be approved by the voter, according to the threshold policy
+func (m *ApprovalMethod) threshold(v *Voter) float64 {
+	switch m.Threshold {
+	case thresholdMean:
+		sum := 0.0
+		for _, u := range v.Utilities {
+			sum += u
+		}
+		return sum / float64(len(v.Utilities))
+
+	case thresholdMidrange:
+		least := v.Utilities[0]
+		most := v.Utilities[0]
+		for _, u := range v.Utilities {
+			least = math.Min(least, u)
+			most = math.Max(most, u)
+		}
+		return (least + most) / 2
+	}
+
+	return v.ApprovalThreshold
+}
+
 //VoteStrategic creates a ballot for a strategic voter
 func (m *ApprovalMethod) VoteStrategic(v *Voter) ApprovalBallot {
 	//strategic approval voters will bullet vote if their preferred candidate is a major
@@ -0,0 +1,49 @@
+package main
+
+// ApprovalRunoffMethod : Approval with a top two runoff. Each voter approves of candidates exactly as in ApprovalMethod.
+// The two most approved candidates go to a runoff, where every voter honestly chooses whichever finalist they prefer.
+type ApprovalRunoffMethod struct {
+	threshold  string      //approval threshold policy, as in ApprovalMethod
+	candidates []Candidate //candidates from the most recent election, used to name the finalists
+	finalists  [2]int      //indices of the two most approved candidates
+	tally      [2]int      //number of votes for each finalist in the runoff
+}
+
+// NewAdaptedApprovalRunoffMethod is a convenience function to construct an ApprovalRunoffMethod and adapt it to the normal Method interface.
+// threshold is the approval threshold policy used by honest voters, as in ApprovalMethod.
+func NewAdaptedApprovalRunoffMethod(threshold string) AdaptedMethod {
+	return AdaptSimpleMethod(&ApprovalRunoffMethod{threshold: threshold, finalists: [2]int{-1, -1}})
+}
+
+// FindWinner finds the index of the approval runoff winner of the provided Electorate.
+func (m *ApprovalRunoffMethod) FindWinner(electorate *Electorate) int {
+	m.candidates = electorate.Candidates
+
+	am := ApprovalMethod{Electorate: electorate, Threshold: m.threshold}
+	votes := make([]int, len(electorate.Candidates))
+
+	for i := range electorate.Voters {
+		var ballot ApprovalBallot
+		if electorate.Voters[i].Strategic {
+			ballot = am.VoteStrategic(&electorate.Voters[i])
+		} else {
+			ballot = am.Vote(&electorate.Voters[i])
+		}
+
+		for j, approved := range ballot.Approvals {
+			if approved {
+				votes[j]++
+			}
+		}
+	}
+
+	m.finalists = findTopTwo(votes)
+	m.tally = honestRunoff(electorate, m.finalists)
+
+	return runoffWinner(m.finalists, m.tally)
+}
+
+// GetDetails describes the runoff
+func (m *ApprovalRunoffMethod) GetDetails() string {
+	return describeRunoff(m.candidates, m.finalists, m.tally)
+}
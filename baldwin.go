@@ -0,0 +1,39 @@
+package main
+
+// BaldwinMethod : Each voter ranks every candidate, with ballots cast exactly as in IRVMethod.
+// Borda scores are calculated and the candidate with the lowest score is eliminated. Borda scores are then recalculated
+// among the remaining candidates, and elimination continues until one candidate remains.
+// As in IRVMethod, a tie for elimination removes the candidate with the highest index.
+type BaldwinMethod struct{}
+
+// NewAdaptedBaldwinMethod is a convenience function to construct a BaldwinMethod and adapt it to the normal Method interface.
+func NewAdaptedBaldwinMethod() AdaptedMethod {
+	return AdaptSimpleMethod(&BaldwinMethod{})
+}
+
+// FindWinner finds the index of the Baldwin winner of the provided Electorate.
+func (m *BaldwinMethod) FindWinner(electorate *Electorate) int {
+	ballots := rankedBallots(electorate)
+	remaining := allCandidates(len(electorate.Candidates))
+
+	for numRemaining := len(remaining); numRemaining > 1; numRemaining-- {
+		scores := bordaScores(ballots, remaining)
+
+		loser := -1
+		for c := range scores {
+			if remaining[c] && (loser < 0 || scores[c] <= scores[loser]) {
+				loser = c
+			}
+		}
+
+		remaining[loser] = false
+	}
+
+	for c := range remaining {
+		if remaining[c] {
+			return c
+		}
+	}
+
+	return 0
+}
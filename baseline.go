@@ -0,0 +1,60 @@
+package main
+
+// random number streams for methods that need them, see Electorate.methodRand
+const (
+	randomCandidateStream = iota + 1
+	randomBallotStream
+	tieBreakStream
+)
+
+// RandomCandidateMethod : A baseline that ignores the voters entirely and elects a candidate chosen at random.
+// Its average efficiency is the floor any sensible method should beat.
+type RandomCandidateMethod struct{}
+
+// NewAdaptedRandomCandidateMethod is a convenience function to construct a RandomCandidateMethod and adapt it to the normal Method interface.
+func NewAdaptedRandomCandidateMethod() AdaptedMethod {
+	return AdaptSimpleMethod(&RandomCandidateMethod{})
+}
+
+// FindWinner picks a random candidate from the provided Electorate.
+func (m *RandomCandidateMethod) FindWinner(electorate *Electorate) int {
+	r := electorate.methodRand(randomCandidateStream)
+	return r.Intn(len(electorate.Candidates))
+}
+
+// RandomBallotMethod : Random ballot, also known as random dictator. Every voter casts a ballot exactly as in
+// PluralityMethod, then a single ballot is drawn at random and its choice wins.
+type RandomBallotMethod struct{}
+
+// NewAdaptedRandomBallotMethod is a convenience function to construct a RandomBallotMethod and adapt it to the normal Method interface.
+func NewAdaptedRandomBallotMethod() AdaptedMethod {
+	return AdaptSimpleMethod(&RandomBallotMethod{})
+}
+
+// FindWinner draws a random ballot from the provided Electorate.
+func (m *RandomBallotMethod) FindWinner(electorate *Electorate) int {
+	r := electorate.methodRand(randomBallotStream)
+	voter := &electorate.Voters[r.Intn(len(electorate.Voters))]
+
+	//only the drawn ballot matters, so it's the only one that is cast
+	pm := PluralityMethod{Electorate: electorate}
+	if voter.Strategic {
+		return pm.VoteStrategic(voter).Choice
+	}
+
+	return pm.Vote(voter).Choice
+}
+
+// UtilityWinnerMethod : An oracle that always elects the candidate with the highest average utility, found by
+// Electorate.findUtilityWinner. Its efficiency is always 1 and it is the ceiling no method can beat.
+type UtilityWinnerMethod struct{}
+
+// NewAdaptedUtilityWinnerMethod is a convenience function to construct a UtilityWinnerMethod and adapt it to the normal Method interface.
+func NewAdaptedUtilityWinnerMethod() AdaptedMethod {
+	return AdaptSimpleMethod(&UtilityWinnerMethod{})
+}
+
+// FindWinner returns the utility winner of the provided Electorate.
+func (m *UtilityWinnerMethod) FindWinner(electorate *Electorate) int {
+	return electorate.UtilityWinner
+}
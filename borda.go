@@ -0,0 +1,57 @@
+package main
+
+// BordaMethod : Each voter ranks every candidate. A candidate gets one point for each candidate ranked below them on a ballot.
+// The winner is the candidate with the most points.
+// Strategic voters rank their preferred major candidate first and bury the other major candidate at the bottom.
+type BordaMethod struct {
+	tieBreak TieBreak //rule for choosing between candidates with the most points
+}
+
+// NewAdaptedBordaMethod is a convenience function to construct a BordaMethod and adapt it to the normal Method interface.
+func NewAdaptedBordaMethod(tieBreak TieBreak) AdaptedMethod {
+	return AdaptSimpleMethod(&BordaMethod{tieBreak})
+}
+
+// FindWinner finds the index of the Borda winner of the provided Electorate.
+func (m *BordaMethod) FindWinner(electorate *Electorate) int {
+	remaining := allCandidates(len(electorate.Candidates))
+	return m.tieBreak.pickLargest(toFloats(bordaScores(rankedBallots(electorate), remaining)), electorate)
+}
+
+// bordaScores totals the Borda points for each remaining candidate across all ballots, as if eliminated candidates
+// were never on the ballot. With n remaining candidates, a first choice earns n-1 points and a last choice earns 0.
+// Eliminated candidates score 0.
+func bordaScores(ballots []IRVBallot, remaining []bool) []int {
+	scores := make([]int, len(remaining))
+
+	numRemaining := 0
+	for _, r := range remaining {
+		if r {
+			numRemaining++
+		}
+	}
+
+	for _, ballot := range ballots {
+		rank := 0
+		for _, c := range ballot.Choices {
+			if !remaining[c] {
+				continue
+			}
+
+			scores[c] += numRemaining - 1 - rank
+			rank++
+		}
+	}
+
+	return scores
+}
+
+// allCandidates returns a slice marking every one of numCandidates candidates as remaining
+func allCandidates(numCandidates int) []bool {
+	remaining := make([]bool, numCandidates)
+	for i := range remaining {
+		remaining[i] = true
+	}
+
+	return remaining
+}
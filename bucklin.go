@@ -0,0 +1,35 @@
+package main
+
+// BucklinMethod : Each voter ranks every candidate, with ballots cast exactly as in IRVMethod.
+// First choices are counted, and if a candidate is ranked on more than half of the ballots they win.
+// If not, second choices are added to the counts, then third choices, and so on until some candidate has a majority.
+// If more than one candidate reaches a majority in the same round, the one with the most votes wins.
+type BucklinMethod struct{}
+
+// NewAdaptedBucklinMethod is a convenience function to construct a BucklinMethod and adapt it to the normal Method interface.
+func NewAdaptedBucklinMethod() AdaptedMethod {
+	return AdaptSimpleMethod(&BucklinMethod{})
+}
+
+// FindWinner finds the index of the Bucklin winner of the provided Electorate.
+func (m *BucklinMethod) FindWinner(electorate *Electorate) int {
+	ballots := rankedBallots(electorate)
+	votes := make([]int, len(electorate.Candidates))
+
+	for round := 0; round < len(electorate.Candidates); round++ {
+		//add the choices at this rank
+		for _, b := range ballots {
+			if round < len(b.Choices) {
+				votes[b.Choices[round]]++
+			}
+		}
+
+		leader := findLargestIndex(votes)
+		if votes[leader] > len(ballots)/2 {
+			return leader
+		}
+	}
+
+	//once every rank has been counted every candidate has a majority, so this is never reached
+	return findLargestIndex(votes)
+}
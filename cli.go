@@ -0,0 +1,126 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const usageText = `Usage: electionsim [options]
+
+Runs every selected voting method on randomly generated electorates and prints a summary of how well each one did.
+Parameters are read from a json file (params.json by default). Any of the options below that are given replace the
+value from the file. Names, Sweep and the options of each method are lists and objects that are only set in the file.
+
+Options:
+`
+
+// parseCommandLine reads the parameters file and applies any overrides given on the command line.
+// It returns flag.ErrHelp if the user asked for the usage text.
+func parseCommandLine(args []string, output io.Writer) (AppParams, error) {
+	fs := flag.NewFlagSet("electionsim", flag.ContinueOnError)
+	fs.SetOutput(output)
+	fs.Usage = func() {
+		fmt.Fprint(output, usageText)
+		fs.PrintDefaults()
+	}
+
+	paramsPath := fs.String("params", "params.json", "path of the json `file` holding the parameters")
+	electorates := fs.Int("electorates", 0, "number of electorates to run (NumElectorates)")
+	maxElectorates := fs.Int("max-electorates", 0, "most electorates to run while reaching the target standard error (MaxElectorates)")
+	stdErr := fs.Float64("stderr", 0, "target standard error of every method's efficiency, 0 for none (TargetStdErr)")
+	minVoters := fs.Int("min-voters", 0, "fewest voters in an electorate (MinVoters)")
+	maxVoters := fs.Int("max-voters", 0, "most voters in an electorate (MaxVoters)")
+	minCandidates := fs.Int("min-candidates", 0, "fewest candidates in an electorate (MinCandidates)")
+	maxCandidates := fs.Int("max-candidates", 0, "most candidates in an electorate (MaxCandidates)")
+	majors := fs.Int("majors", 0, "number of major candidates, 0 or 2 (NumMajorCandidates)")
+	workers := fs.Int("workers", 0, "number of concurrent workers (NumWorkers)")
+	strategic := fs.Float64("strategic", 0, "chance that a voter is strategic, 0.0 to 1.0 (StrategicVoters)")
+	axes := fs.Int("axes", 0, "number of ideological axes (NumAxes)")
+	seed := fs.Int64("seed", 0, "master seed, 0 picks a new seed (Seed)")
+	methods := fs.String("methods", "", "comma separated `names` of the methods to run, such as Plurality,IRV (Methods)")
+	out := fs.String("out", "", "`file` to write results to, ending in .csv or .json (Output)")
+	log := fs.String("log", "", "`file` to write every electorate's results to as JSON lines (ElectorateLog)")
+
+	if err := fs.Parse(args); err != nil {
+		return AppParams{}, err
+	}
+
+	if fs.NArg() > 0 {
+		fs.Usage()
+		return AppParams{}, fmt.Errorf("unexpected argument %q", fs.Arg(0))
+	}
+
+	params, err := readParams(*paramsPath)
+	if err != nil {
+		return params, err
+	}
+
+	//only flags that were actually given replace values from the file
+	fs.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "electorates":
+			params.NumElectorates = *electorates
+		case "max-electorates":
+			params.MaxElectorates = *maxElectorates
+		case "stderr":
+			params.TargetStdErr = *stdErr
+		case "min-voters":
+			params.MinVoters = *minVoters
+		case "max-voters":
+			params.MaxVoters = *maxVoters
+		case "min-candidates":
+			params.MinCandidates = *minCandidates
+		case "max-candidates":
+			params.MaxCandidates = *maxCandidates
+		case "majors":
+			params.NumMajorCandidates = *majors
+		case "workers":
+			params.NumWorkers = *workers
+		case "strategic":
+			params.StrategicVoters = *strategic
+		case "axes":
+			params.NumAxes = *axes
+		case "seed":
+			params.Seed = *seed
+		case "methods":
+			params.Methods = selectMethods(params.Methods, splitList(*methods))
+		case "out":
+			params.Output = *out
+		case "log":
+			params.ElectorateLog = *log
+		}
+	})
+
+	return params, nil
+}
+
+// selectMethods picks the named methods out of those configured in the parameters file, keeping their options
+// names that aren't configured there are run as the registered method of that name with its default options
+func selectMethods(configured []MethodSpec, names []string) []MethodSpec {
+	selected := make([]MethodSpec, len(names))
+	for i, name := range names {
+		selected[i] = MethodSpec{Name: name}
+		for _, spec := range configured {
+			if spec.name() == name {
+				selected[i] = spec
+				break
+			}
+		}
+	}
+
+	return selected
+}
+
+// splitList splits a comma separated list, trimming spaces and dropping empty entries
+func splitList(list string) []string {
+	items := make([]string, 0)
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
@@ -6,6 +6,9 @@ import ()
 func (e *Electorate) findCondorcetWinner() {
 	winner := -1
 
+	//every matchup is counted at once from the voters' utilities
+	t := e.utilityTally()
+
 Loop:
 	for i := range e.Candidates {
 		for j := range e.Candidates {
@@ -13,7 +16,8 @@ Loop:
 				continue
 			}
 
-			if e.headToHead(i, j) == false {
+			//a majority of all voters must prefer i
+			if t.Wins[i][j] <= len(e.Voters)/2 {
 				continue Loop
 			}
 		}
@@ -25,15 +29,142 @@ Loop:
 	e.CondorcetWinner = winner
 }
 
-// true if the candidate at index 1 (i1) beats the candidate at index 2 (i2) in a head-to-head matchup
-func (e *Electorate) headToHead(i1, i2 int) bool {
-	votes := 0
+//PairwiseTally holds the result of every head-to-head matchup between the candidates in an electorate
+type PairwiseTally struct {
+	Wins [][]int //Wins[i][j] is the number of voters preferring candidate i to candidate j
+}
+
+//creates an empty tally for the given number of candidates
+func newPairwiseTally(numCandidates int) PairwiseTally {
+	t := PairwiseTally{Wins: make([][]int, numCandidates)}
+	for i := range t.Wins {
+		t.Wins[i] = make([]int, numCandidates)
+	}
+
+	return t
+}
+
+//adds a single ranked ballot to the tally. Candidates earlier in choices are preferred to those that come after
+func (t *PairwiseTally) addRanking(choices []int) {
+	for rank, i := range choices {
+		for _, j := range choices[rank+1:] {
+			if i != j {
+				t.Wins[i][j]++
+			}
+		}
+	}
+}
+
+//builds the pairwise tally of the ranked ballots voters would cast, honest or strategic, in a single pass over the voters
+func (e *Electorate) ballotTally() PairwiseTally {
+	t := newPairwiseTally(len(e.Candidates))
+
+	for i := range e.Voters {
+		t.addRanking(rankBallot(&e.Voters[i], e.Candidates))
+	}
+
+	return t
+}
+
+//builds the pairwise tally of ranked ballots that have already been cast
+func tallyBallots(ballots []IRVBallot, numCandidates int) PairwiseTally {
+	t := newPairwiseTally(numCandidates)
+
+	for _, b := range ballots {
+		t.addRanking(b.Choices)
+	}
+
+	return t
+}
+
+//builds the pairwise tally of the voters' honest preferences in a single pass over the voters
+func (e *Electorate) utilityTally() PairwiseTally {
+	t := newPairwiseTally(len(e.Candidates))
 
 	for _, v := range e.Voters {
-		if v.Utilities[i1] > v.Utilities[i2] {
-			votes++
+		for i := range v.Utilities {
+			for j := range v.Utilities {
+				if v.Utilities[i] > v.Utilities[j] {
+					t.Wins[i][j]++
+				}
+			}
+		}
+	}
+
+	return t
+}
+
+//the number of candidates in the tally
+func (t *PairwiseTally) size() int {
+	return len(t.Wins)
+}
+
+//true if more voters prefer candidate i to candidate j than the other way around
+func (t *PairwiseTally) beats(i, j int) bool {
+	return t.Wins[i][j] > t.Wins[j][i]
+}
+
+//the number of voters preferring candidate i to candidate j minus the number preferring j to i
+func (t *PairwiseTally) margin(i, j int) int {
+	return t.Wins[i][j] - t.Wins[j][i]
+}
+
+//finds the Smith set among the given candidates: the smallest group of candidates that each beat every candidate outside it
+//candidates are returned in index order. A Condorcet winner is a Smith set of one
+func (t *PairwiseTally) smithSet(candidates []int) []int {
+	n := len(candidates)
+
+	//reach[a][b] is true if candidates[a] beats or ties candidates[b], directly or through a chain of other candidates
+	reach := make([][]bool, n)
+	for a := range reach {
+		reach[a] = make([]bool, n)
+		for b := range reach[a] {
+			reach[a][b] = a == b || !t.beats(candidates[b], candidates[a])
+		}
+	}
+
+	for k := 0; k < n; k++ {
+		for a := 0; a < n; a++ {
+			if !reach[a][k] {
+				continue
+			}
+			for b := 0; b < n; b++ {
+				if reach[k][b] {
+					reach[a][b] = true
+				}
+			}
 		}
 	}
 
-	return votes > len(e.Voters)/2
+	//a candidate is in the Smith set if they can reach every other candidate
+	smith := make([]int, 0)
+
+CandidateLoop:
+	for a := 0; a < n; a++ {
+		for b := 0; b < n; b++ {
+			if !reach[a][b] {
+				continue CandidateLoop
+			}
+		}
+
+		smith = append(smith, candidates[a])
+	}
+
+	return smith
+}
+
+//finds the candidate among those given that beats each of the others, or -1 if there isn't one
+func (t *PairwiseTally) condorcetWinner(candidates []int) int {
+CandidateLoop:
+	for _, i := range candidates {
+		for _, j := range candidates {
+			if i != j && !t.beats(i, j) {
+				continue CandidateLoop
+			}
+		}
+
+		return i
+	}
+
+	return -1
 }
@@ -0,0 +1,58 @@
+package main
+
+// CoombsMethod : Each voter ranks every candidate, with ballots cast exactly as in IRVMethod.
+// If a remaining candidate is the first choice on more than half of the ballots, they win.
+// If not, the remaining candidate ranked last on the most ballots is eliminated and the ballots are counted again.
+// As in IRVMethod, a tie for elimination removes the candidate with the highest index.
+type CoombsMethod struct{}
+
+// NewAdaptedCoombsMethod is a convenience function to construct a CoombsMethod and adapt it to the normal Method interface.
+func NewAdaptedCoombsMethod() AdaptedMethod {
+	return AdaptSimpleMethod(&CoombsMethod{})
+}
+
+// FindWinner finds the index of the Coombs winner of the provided Electorate.
+func (m *CoombsMethod) FindWinner(electorate *Electorate) int {
+	ballots := rankedBallots(electorate)
+	numCandidates := len(electorate.Candidates)
+
+	remaining := allCandidates(numCandidates)
+
+	for numRemaining := numCandidates; ; numRemaining-- {
+		firsts := make([]int, numCandidates)
+		lasts := make([]int, numCandidates)
+
+		//find the highest and lowest ranked remaining candidates on each ballot
+		for _, b := range ballots {
+			first, last := -1, -1
+			for _, c := range b.Choices {
+				if !remaining[c] {
+					continue
+				}
+				if first < 0 {
+					first = c
+				}
+				last = c
+			}
+
+			if first >= 0 {
+				firsts[first]++
+				lasts[last]++
+			}
+		}
+
+		leader := findLargestIndex(firsts)
+		if firsts[leader] > len(ballots)/2 || numRemaining == 1 {
+			return leader
+		}
+
+		loser := -1
+		for c := range lasts {
+			if remaining[c] && (loser < 0 || lasts[c] >= lasts[loser]) {
+				loser = c
+			}
+		}
+
+		remaining[loser] = false
+	}
+}
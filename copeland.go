@@ -0,0 +1,43 @@
+package main
+
+// CopelandMethod : Each voter ranks every candidate and every head-to-head matchup is counted.
+// A candidate earns 1 point for each pairwise victory and a configurable number of points for each pairwise tie.
+// The winner is the candidate with the most points. A Condorcet winner always wins.
+// Strategic voters rank their preferred major candidate first and bury the other major candidate at the bottom.
+type CopelandMethod struct {
+	tiePoints float64  //points earned for a pairwise tie, usually 0.5
+	tieBreak  TieBreak //rule for choosing between candidates with the most points
+}
+
+// NewCopelandMethod is the "constructor" for CopelandMethod. It requires the number of points awarded for a pairwise tie.
+func NewCopelandMethod(tiePoints float64) CopelandMethod {
+	return CopelandMethod{tiePoints: tiePoints}
+}
+
+// NewAdaptedCopelandMethod is a convenience function to construct a CopelandMethod and adapt it to the normal Method interface.
+func NewAdaptedCopelandMethod(tiePoints float64) AdaptedMethod {
+	copelandMethod := NewCopelandMethod(tiePoints)
+	return AdaptSimpleMethod(&copelandMethod)
+}
+
+// FindWinner finds the index of the Copeland winner of the provided Electorate.
+func (m *CopelandMethod) FindWinner(electorate *Electorate) int {
+	t := electorate.ballotTally()
+
+	points := make([]float64, t.size())
+	for i := range points {
+		for j := 0; j < t.size(); j++ {
+			if i == j {
+				continue
+			}
+
+			if t.beats(i, j) {
+				points[i] += 1.0
+			} else if !t.beats(j, i) {
+				points[i] += m.tiePoints
+			}
+		}
+	}
+
+	return m.tieBreak.pickLargest(points, electorate)
+}
@@ -0,0 +1,61 @@
+package main
+
+// CumulativeMethod : Each voter has the same number of points to divide among the candidates however they like.
+// The winner is the candidate with the most points.
+// Honest voters divide their points in proportion to how much they like each candidate, on a scale where their least
+// favorite candidate is 0 and their favorite is 1. Strategic voters give all of their points to their preferred major candidate.
+type CumulativeMethod struct {
+	points   float64  //number of points each voter distributes
+	tieBreak TieBreak //rule for choosing between candidates with the most votes
+}
+
+// NewCumulativeMethod is the "constructor" for CumulativeMethod. It requires the number of points each voter distributes.
+func NewCumulativeMethod(points float64) CumulativeMethod {
+	return CumulativeMethod{points: points}
+}
+
+// NewAdaptedCumulativeMethod is a convenience function to construct a CumulativeMethod and adapt it to the normal Method interface.
+func NewAdaptedCumulativeMethod(points float64) AdaptedMethod {
+	cumulativeMethod := NewCumulativeMethod(points)
+	return AdaptSimpleMethod(&cumulativeMethod)
+}
+
+// FindWinner finds the index of the cumulative voting winner of the provided Electorate.
+func (m *CumulativeMethod) FindWinner(electorate *Electorate) int {
+	sums := make([]float64, len(electorate.Candidates))
+
+	for _, voter := range electorate.Voters {
+		for j, points := range m.vote(&voter, electorate.Candidates) {
+			sums[j] += points
+		}
+	}
+
+	return m.tieBreak.pickLargest(sums, electorate)
+}
+
+func (m *CumulativeMethod) vote(voter *Voter, candidates []Candidate) []float64 {
+	ballot := make([]float64, len(voter.Utilities))
+
+	if voter.Strategic {
+		ballot[findFavoriteMajor(voter.Utilities, candidates)] = m.points
+		return ballot
+	}
+
+	normalized := normalizeUtilities(voter.Utilities)
+
+	total := 0.0
+	for _, u := range normalized {
+		total += u
+	}
+
+	//a voter that likes every candidate equally has no reason to spend any points
+	if total == 0 {
+		return ballot
+	}
+
+	for i, u := range normalized {
+		ballot[i] = m.points * u / total
+	}
+
+	return ballot
+}
@@ -2,17 +2,20 @@ package main
 
 import (
 	"math/rand"
-	"sync"
 )
 
 //Electorate is a collection of Voters and Candidates
 type Electorate struct {
+	Index           int               //position of the electorate in the study
+	Seed            int64             //seed of the random source used to generate this electorate
 	Voters          []Voter           //slice of all voters in electorate
 	Candidates      []Candidate       //slice of all candidates
 	MaxUtility      float64           //average utility per voter for max utility candidate
+	RandomUtility   float64           //expected average utility per voter for a randomly chosen candidate
 	UtilityWinner   int               //index of max utility candidate
 	CondorcetWinner int               //index of the condorcet winner
 	Methods         map[string]Method //map of Method interfaces with name of election method as key
+	MethodNames     []string          //names of the methods in the order they are run and reported
 }
 
 //Voter represents an individual voter with unique alignments in each axis and a flag for whether the voter is "strategic"
@@ -32,31 +35,40 @@ type Candidate struct {
 
 //Report is a summary of the performance of all methods run in an electorate
 type Report struct {
+	Index           int                   //position of the electorate in the study
+	Seed            int64                 //seed used to generate the electorate
 	NumVoters       int                   //number of voters in the electorate
 	NumCandidates   int                   //number of candidates on the ballot
 	CondorcetWinner int                   //index of condorcet winner
 	UtilityWinner   int                   //index of highest utility candidate
 	Lines           map[string]ReportLine //summary for each method, name of method as key
+	Names           []string              //names of the methods in the order they were run
 }
 
 //ReportLine is a single line in a report, covering one voting method
 type ReportLine struct {
 	Winner     int     //index of the winning Candidate
 	Efficiency float64 //the fraction of maximum possible efficiency achieved with the winning candidate
+	VSE        float64 //voter satisfaction efficiency: 1 for the best candidate, 0 for the average of a random candidate
 	Condorcet  int     //whether the Condorcet winner was elected. 0 for false, 1 for true, -1 means there was no Condorcet winner.
+	Details    string  //extra information from methods that implement DetailedMethod
 }
 
 //GetReport creates and returns a Report, which is a summary of the performance of methods tested for this electorate
 func (e *Electorate) GetReport() Report {
 	r := Report{
+		Index:           e.Index,
+		Seed:            e.Seed,
 		NumVoters:       len(e.Voters),
 		NumCandidates:   len(e.Candidates),
 		CondorcetWinner: e.CondorcetWinner,
 		UtilityWinner:   e.UtilityWinner,
 		Lines:           make(map[string]ReportLine),
+		Names:           e.MethodNames,
 	}
 
-	for name, m := range e.Methods {
+	for _, name := range e.MethodNames {
+		m := e.Methods[name]
 		c := -1
 
 		//mark whether the condorcet winner was matched by this method
@@ -70,39 +82,61 @@ func (e *Electorate) GetReport() Report {
 		}
 
 		//add the method's result to the report
-		r.Lines[name] = ReportLine{
+		l := ReportLine{
 			Winner:     m.GetWinner(),
 			Efficiency: m.GetUtility() / e.MaxUtility,
+			VSE:        e.vse(m.GetUtility()),
 			Condorcet:  c,
 		}
+
+		if d, ok := m.(DetailedMethod); ok {
+			l.Details = d.GetDetails()
+		}
+
+		r.Lines[name] = l
 	}
 
 	return r
 }
 
-func makeElectorate(params *AppParams, r *rand.Rand, mu *sync.Mutex) Electorate {
-	e := Electorate{}
+//vse measures the utility of a winner on the voter satisfaction efficiency scale, where electing the best candidate
+//scores 1 and picking a candidate at random scores 0 on average
+func (e *Electorate) vse(utility float64) float64 {
+	//if every candidate is equally good, whoever wins is as good as the best
+	if e.MaxUtility == e.RandomUtility {
+		return 1.0
+	}
+
+	return (utility - e.RandomUtility) / (e.MaxUtility - e.RandomUtility)
+}
+
+func makeElectorate(params *AppParams, index int) Electorate {
+	e := Electorate{
+		Index: index,
+		Seed:  electorateSeed(params.Seed, index),
+	}
+
+	//each electorate has its own random source, so no locking is needed and results don't depend on which worker runs it
+	r := rand.New(rand.NewSource(e.Seed))
 
-	//lock random number generator, decide number of candidates, decide number of voters
-	mu.Lock()
+	//decide number of candidates, decide number of voters
 	numCandidates := r.Intn(params.MaxCandidates-params.MinCandidates+1) + params.MinCandidates
 	numVoters := r.Intn(params.MaxVoters-params.MinVoters+1) + params.MinVoters
-	mu.Unlock()
 
 	//create candidates
 	e.Candidates = make([]Candidate, numCandidates)
 	for i := 0; i < numCandidates; i++ {
 		if i < params.NumMajorCandidates {
-			e.Candidates[i] = makeMajorCandidate(params.Names[i], params.NumAxes, i, r, mu)
+			e.Candidates[i] = makeMajorCandidate(params.Names[i], params.NumAxes, i, r)
 		} else {
-			e.Candidates[i] = makeCandidate(params.Names[i], params.NumAxes, r, mu)
+			e.Candidates[i] = makeCandidate(params.Names[i], params.NumAxes, r)
 		}
 	}
 
 	//create Voters
 	e.Voters = make([]Voter, numVoters)
 	for i := 0; i < numVoters; i++ {
-		e.Voters[i] = makeVoter(params.NumAxes, params.StrategicVoters, e.Candidates, r, mu)
+		e.Voters[i] = makeVoter(params.NumAxes, params.StrategicVoters, e.Candidates, r)
 	}
 
 	//create map for methods
@@ -115,18 +149,33 @@ func makeElectorate(params *AppParams, r *rand.Rand, mu *sync.Mutex) Electorate
 	return e
 }
 
+//derives the seed for a single electorate from the master seed
+//the bits are mixed (splitmix64) so that neighboring electorates get unrelated random streams
+func electorateSeed(masterSeed int64, index int) int64 {
+	z := uint64(masterSeed) + uint64(index+1)*0x9e3779b97f4a7c15
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	z = z ^ (z >> 31)
+
+	return int64(z)
+}
+
+//creates a random source for a method that needs random numbers, derived from the electorate's seed
+//each method uses its own stream number so that no two methods share random numbers
+func (e *Electorate) methodRand(stream int) *rand.Rand {
+	return rand.New(rand.NewSource(electorateSeed(e.Seed, stream)))
+}
+
 //create a single voter
-func makeVoter(numAxes int, strategicChance float64, candidates []Candidate, r *rand.Rand, mu *sync.Mutex) Voter {
+func makeVoter(numAxes int, strategicChance float64, candidates []Candidate, r *rand.Rand) Voter {
 	//create the ideological axes
 	axes := make([]float64, numAxes)
 
-	//lock the random number generator, populate the axes, decide whether voter is strategic
-	mu.Lock()
+	//populate the axes, decide whether voter is strategic
 	for i := 0; i < len(axes); i++ {
 		axes[i] = r.Float64()
 	}
 	isStrategic := r.Float64() <= strategicChance
-	mu.Unlock()
 
 	//create slice of utilities used to hold voter's utility from each candidate
 	utilities := make([]float64, len(candidates))
@@ -148,16 +197,14 @@ func makeVoter(numAxes int, strategicChance float64, candidates []Candidate, r *
 }
 
 //creates a single candidate that is not a "major"
-func makeCandidate(name string, numAxes int, r *rand.Rand, mu *sync.Mutex) Candidate {
+func makeCandidate(name string, numAxes int, r *rand.Rand) Candidate {
 	//create the ideological axes
 	axes := make([]float64, numAxes)
 
-	//lock the random number generator and populate the axes
-	mu.Lock()
+	//populate the axes
 	for i := 0; i < len(axes); i++ {
 		axes[i] = r.Float64()
 	}
-	mu.Unlock()
 
 	//populate and return Candidate struct
 	c := Candidate{
@@ -170,7 +217,7 @@ func makeCandidate(name string, numAxes int, r *rand.Rand, mu *sync.Mutex) Candi
 }
 
 //creates a single candidate that is a "major"
-func makeMajorCandidate(name string, numAxes int, index int, r *rand.Rand, mu *sync.Mutex) Candidate {
+func makeMajorCandidate(name string, numAxes int, index int, r *rand.Rand) Candidate {
 	//create the ideological axes
 	axes := make([]float64, numAxes)
 
@@ -179,13 +226,11 @@ func makeMajorCandidate(name string, numAxes int, index int, r *rand.Rand, mu *s
 	min := float64(zone) * 0.5
 	max := float64(zone)*0.5 + 0.5
 
-	//lock the random number generator and populate the axes
+	//populate the axes
 	//a major candidate has all of their alignments in the same quadrant/octant, where axis crossing are at 0.5
-	mu.Lock()
 	for i := 0; i < len(axes); i++ {
 		axes[i] = min + r.Float64()*(max-min)
 	}
-	mu.Unlock()
 
 	//populate and return Candidate struct
 	c := Candidate{
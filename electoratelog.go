@@ -0,0 +1,86 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+)
+
+// ElectorateLog streams the result of every electorate to a file as JSON lines, one line per electorate
+type ElectorateLog struct {
+	file  *os.File
+	enc   *json.Encoder
+	point map[string]float64 //values of the swept fields for the study being run, nil when there's no sweep
+	err   error              //first error while writing, reported by Close
+}
+
+// ElectorateRecord is a single line of the ElectorateLog
+type ElectorateRecord struct {
+	Point           map[string]float64 `json:",omitempty"` //values of the swept fields, only present in a sweep
+	Index           int                //position of the electorate in its study
+	Seed            int64              //seed used to generate the electorate
+	Voters          int                //number of voters
+	Candidates      int                //number of candidates
+	CondorcetWinner int                //index of the Condorcet winner, -1 if there isn't one
+	UtilityWinner   int                //index of the highest utility candidate
+	Methods         []MethodRecord     //result of each method, in the order the methods are run
+}
+
+// MethodRecord is the result of one method in an ElectorateRecord
+type MethodRecord struct {
+	Name       string  //name of the method, as it appears in reports
+	Winner     int     //index of the winning candidate
+	Efficiency float64 //utility efficiency of the winner
+	VSE        float64 //voter satisfaction efficiency of the winner
+	Condorcet  int     //1 if the Condorcet winner was elected, 0 if not, -1 if there was no Condorcet winner
+}
+
+// openElectorateLog creates the file at path, replacing any file already there
+func openElectorateLog(path string) (*ElectorateLog, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ElectorateLog{file: f, enc: json.NewEncoder(f)}, nil
+}
+
+// write adds a line for one electorate. Errors are kept until Close so a failed write doesn't stop the study
+func (l *ElectorateLog) write(r *Report) {
+	if l == nil || l.err != nil {
+		return
+	}
+
+	rec := ElectorateRecord{
+		Point:           l.point,
+		Index:           r.Index,
+		Seed:            r.Seed,
+		Voters:          r.NumVoters,
+		Candidates:      r.NumCandidates,
+		CondorcetWinner: r.CondorcetWinner,
+		UtilityWinner:   r.UtilityWinner,
+		Methods:         make([]MethodRecord, len(r.Names)),
+	}
+
+	for i, name := range r.Names {
+		line := r.Lines[name]
+		rec.Methods[i] = MethodRecord{
+			Name:       name,
+			Winner:     line.Winner,
+			Efficiency: line.Efficiency,
+			VSE:        line.VSE,
+			Condorcet:  line.Condorcet,
+		}
+	}
+
+	l.err = l.enc.Encode(rec)
+}
+
+// Close closes the file and returns the first error that happened while writing, if any
+func (l *ElectorateLog) Close() error {
+	err := l.file.Close()
+	if l.err != nil {
+		return l.err
+	}
+
+	return err
+}
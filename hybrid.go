@@ -0,0 +1,95 @@
+package main
+
+// SmithIRVMethod : Smith//IRV. Each voter ranks every candidate, with ballots cast exactly as in IRVMethod.
+// Every candidate outside the Smith set of the ballots is eliminated, then an IRV election is held among the rest.
+// A Condorcet winner, when there is one, is the whole Smith set and always wins.
+type SmithIRVMethod struct{}
+
+// NewAdaptedSmithIRVMethod is a convenience function to construct a SmithIRVMethod and adapt it to the normal Method interface.
+func NewAdaptedSmithIRVMethod() AdaptedMethod {
+	return AdaptSimpleMethod(&SmithIRVMethod{})
+}
+
+// FindWinner finds the index of the Smith//IRV winner of the provided Electorate.
+func (m *SmithIRVMethod) FindWinner(electorate *Electorate) int {
+	irv := newHybridIRV(electorate)
+	t := tallyBallots(irv.Ballots, len(electorate.Candidates))
+
+	irv.restrictTo(t.smithSet(irv.remainingCandidates()))
+
+	for {
+		isWinner, ci := irv.checkForWinner()
+		if isWinner {
+			return ci
+		}
+
+		irv.eliminateCandidate(ci)
+	}
+}
+
+// TidemanAlternativeMethod : Tideman's Alternative. Each voter ranks every candidate, with ballots cast exactly as in IRVMethod.
+// Every candidate outside the Smith set of the remaining candidates is eliminated. If more than one candidate is left,
+// the one with the fewest first choices is eliminated as in IRV, and the process repeats with a new Smith set.
+type TidemanAlternativeMethod struct{}
+
+// NewAdaptedTidemanAlternativeMethod is a convenience function to construct a TidemanAlternativeMethod and adapt it to the normal Method interface.
+func NewAdaptedTidemanAlternativeMethod() AdaptedMethod {
+	return AdaptSimpleMethod(&TidemanAlternativeMethod{})
+}
+
+// FindWinner finds the index of the Tideman's Alternative winner of the provided Electorate.
+func (m *TidemanAlternativeMethod) FindWinner(electorate *Electorate) int {
+	irv := newHybridIRV(electorate)
+	t := tallyBallots(irv.Ballots, len(electorate.Candidates))
+
+	for {
+		irv.restrictTo(t.smithSet(irv.remainingCandidates()))
+
+		isWinner, ci := irv.checkForWinner()
+		if isWinner {
+			return ci
+		}
+
+		irv.eliminateCandidate(ci)
+	}
+}
+
+// BenhamMethod : Each voter ranks every candidate, with ballots cast exactly as in IRVMethod.
+// If one of the remaining candidates beats each of the others head-to-head, they win. If not, the candidate with the
+// fewest first choices is eliminated as in IRV and the remaining candidates are checked again.
+type BenhamMethod struct{}
+
+// NewAdaptedBenhamMethod is a convenience function to construct a BenhamMethod and adapt it to the normal Method interface.
+func NewAdaptedBenhamMethod() AdaptedMethod {
+	return AdaptSimpleMethod(&BenhamMethod{})
+}
+
+// FindWinner finds the index of the Benham winner of the provided Electorate.
+func (m *BenhamMethod) FindWinner(electorate *Electorate) int {
+	irv := newHybridIRV(electorate)
+	t := tallyBallots(irv.Ballots, len(electorate.Candidates))
+
+	for {
+		if w := t.condorcetWinner(irv.remainingCandidates()); w > -1 {
+			return w
+		}
+
+		isWinner, ci := irv.checkForWinner()
+		if isWinner {
+			return ci
+		}
+
+		irv.eliminateCandidate(ci)
+	}
+}
+
+// newHybridIRV prepares an IRVMethod whose ballots have been cast and sorted into buckets,
+// ready for the elimination steps of a hybrid method
+func newHybridIRV(electorate *Electorate) *IRVMethod {
+	irv := IRVMethod{}
+	irv.Create(electorate)
+	irv.castBallots()
+	irv.sortBallots(irv.Ballots)
+
+	return &irv
+}
@@ -37,14 +37,7 @@ func (m *IRVMethod) GetUtility() float64 {
 //Run creates ballots and tabulates the winner
 func (m *IRVMethod) Run() {
 
-	//fmt.Println("creating IRV ballots")
-	for i := range m.Electorate.Voters {
-		if m.Electorate.Voters[i].Strategic {
-			m.Ballots[i] = m.VoteStrategic(&m.Electorate.Voters[i])
-		} else {
-			m.Ballots[i] = m.Vote(&m.Electorate.Voters[i])
-		}
-	}
+	m.castBallots()
 
 	m.sortBallots(m.Ballots)
 
@@ -70,6 +63,44 @@ func (m *IRVMethod) Run() {
 	//m.Ballots = nil
 }
 
+//creates a ballot for every voter
+func (m *IRVMethod) castBallots() {
+	//fmt.Println("creating IRV ballots")
+	for i := range m.Electorate.Voters {
+		if m.Electorate.Voters[i].Strategic {
+			m.Ballots[i] = m.VoteStrategic(&m.Electorate.Voters[i])
+		} else {
+			m.Ballots[i] = m.Vote(&m.Electorate.Voters[i])
+		}
+	}
+}
+
+//lists the candidates that haven't been eliminated, in index order
+func (m *IRVMethod) remainingCandidates() []int {
+	remaining := make([]int, 0, len(m.Buckets))
+	for i := range m.Electorate.Candidates {
+		if _, ok := m.Buckets[i]; ok {
+			remaining = append(remaining, i)
+		}
+	}
+
+	return remaining
+}
+
+//eliminates every remaining candidate that isn't in keep
+func (m *IRVMethod) restrictTo(keep []int) {
+	kept := make(map[int]bool)
+	for _, i := range keep {
+		kept[i] = true
+	}
+
+	for _, i := range m.remainingCandidates() {
+		if !kept[i] {
+			m.eliminateCandidate(i)
+		}
+	}
+}
+
 //if there is a winner, returns (true, winner index), otherwise returns (false, last place index)
 func (m *IRVMethod) checkForWinner() (bool, int) {
 	//fmt.Println("checking for winner")
@@ -78,8 +109,11 @@ func (m *IRVMethod) checkForWinner() (bool, int) {
 	loser := -1
 	lowVotes := len(m.Ballots)
 
-	for k := range m.Buckets {
-		//fmt.Println(k, len(b))
+	//candidates are visited in index order rather than map order so that ties are broken the same way every run
+	for k := range m.Electorate.Candidates {
+		if _, ok := m.Buckets[k]; !ok {
+			continue
+		}
 
 		if len(m.Buckets[k]) > highVotes {
 			leader = k
@@ -128,23 +162,18 @@ func (m *IRVMethod) sortBallots(ballots []IRVBallot) {
 			//increment choice on ballot
 			ballots[k].LastChoice++
 
-			//see if that candidate remains
-			_, ok := m.Buckets[ballots[k].LastChoice]
-
-			//if that candidate's index still exists, add ballot to bucket
-			if ok {
-				m.Buckets[ballots[k].Choices[ballots[k].LastChoice]] = append(m.Buckets[ballots[k].Choices[ballots[k].LastChoice]], ballots[k])
+			//if there are no choices left, this ballot is expired and is discarded
+			if ballots[k].LastChoice >= len(ballots[k].Choices) {
 				break
+			}
 
-				//if not, check to see if this ballot is expired and if so, discard ballot
-			} else if ballots[k].LastChoice > len(m.Electorate.Candidates) {
+			//if that candidate still remains, add ballot to their bucket
+			//otherwise, try next choice
+			c := ballots[k].Choices[ballots[k].LastChoice]
+			if _, ok := m.Buckets[c]; ok {
+				m.Buckets[c] = append(m.Buckets[c], ballots[k])
 				break
-
-				//otherwise, try next choice
-			} else {
-				continue
 			}
-
 		}
 	}
 	//fmt.Println("done sorting")
@@ -162,45 +191,74 @@ func (m *IRVMethod) calcUtility() {
 
 //Vote creates a ballot for an honest voter
 func (m *IRVMethod) Vote(v *Voter) IRVBallot {
-	ballot := IRVBallot{Choices: make([]int, 0), LastChoice: -1}
+	return IRVBallot{Choices: rankHonest(v), LastChoice: -1}
+}
+
+//VoteStrategic creates a ballot for a strategic voter
+func (m *IRVMethod) VoteStrategic(v *Voter) IRVBallot {
+	return IRVBallot{Choices: rankStrategic(v, m.Electorate.Candidates), LastChoice: -1}
+}
+
+//rankedBallots creates an honest or strategic ranked ballot for every voter in the electorate
+//this is the ballot generator shared by all of the ranked methods
+func rankedBallots(e *Electorate) []IRVBallot {
+	ballots := make([]IRVBallot, len(e.Voters))
+
+	for i := range e.Voters {
+		ballots[i] = IRVBallot{Choices: rankBallot(&e.Voters[i], e.Candidates), LastChoice: -1}
+	}
+
+	return ballots
+}
+
+//ranks the candidates honestly or strategically, depending on the voter
+func rankBallot(v *Voter, candidates []Candidate) []int {
+	if v.Strategic {
+		return rankStrategic(v, candidates)
+	}
+
+	return rankHonest(v)
+}
+
+//ranks every candidate in order of the voter's utility, favorite first
+func rankHonest(v *Voter) []int {
+	choices := make([]int, 0, len(v.Utilities))
 
 UtilitiesLoop:
 
 	for i, u := range v.Utilities {
 
-		for j, c := range ballot.Choices {
+		for j, c := range choices {
 
 			if u > v.Utilities[c] {
 				//insert i at j
-				ballot.Choices = append(ballot.Choices, 0)
-				copy(ballot.Choices[j+1:], ballot.Choices[j:])
-				ballot.Choices[j] = i
+				choices = append(choices, 0)
+				copy(choices[j+1:], choices[j:])
+				choices[j] = i
 
 				//next utility value
 				continue UtilitiesLoop
 			}
 		}
 
-		//if a lower value isn't found, append to bottom of Choices
-		ballot.Choices = append(ballot.Choices, i)
+		//if a lower value isn't found, append to bottom of choices
+		choices = append(choices, i)
 
 	}
 
-	//fmt.Println("honest", ballot)
-	return ballot
-
+	return choices
 }
 
-//VoteStrategic creates a ballot for a strategic voter
-func (m *IRVMethod) VoteStrategic(v *Voter) IRVBallot {
-	//strategic IRV voters will rank their preferred major candidate first and the other major candidate last
-	preferredMajor := findFavoriteMajor(v.Utilities, m.Electorate.Candidates)
-	otherMajor := findOtherMajor(preferredMajor, m.Electorate.Candidates)
+//ranks the voter's preferred major candidate first and the other major candidate last
+//the remaining candidates are ranked honestly in between
+func rankStrategic(v *Voter, candidates []Candidate) []int {
+	preferredMajor := findFavoriteMajor(v.Utilities, candidates)
+	otherMajor := findOtherMajor(preferredMajor, candidates)
 
-	ballot := IRVBallot{Choices: make([]int, 0), LastChoice: -1}
+	choices := make([]int, 0, len(v.Utilities))
 
 	//first choice is preferred major
-	ballot.Choices = append(ballot.Choices, preferredMajor)
+	choices = append(choices, preferredMajor)
 
 UtilitiesLoop:
 
@@ -211,7 +269,7 @@ UtilitiesLoop:
 			continue
 		}
 
-		for j, c := range ballot.Choices {
+		for j, c := range choices {
 
 			//skip the first spot because it's already set
 			if j == 0 {
@@ -220,25 +278,24 @@ UtilitiesLoop:
 
 			if u > v.Utilities[c] {
 				//insert i at j
-				ballot.Choices = append(ballot.Choices, 0)
-				copy(ballot.Choices[j+1:], ballot.Choices[j:])
-				ballot.Choices[j] = i
+				choices = append(choices, 0)
+				copy(choices[j+1:], choices[j:])
+				choices[j] = i
 
 				//next utility value
 				continue UtilitiesLoop
 			}
 		}
 
-		//if a lower value isn't found, append to bottom of Choices
-		ballot.Choices = append(ballot.Choices, i)
+		//if a lower value isn't found, append to bottom of choices
+		choices = append(choices, i)
 
 	}
 
 	//put other major in last spot
-	ballot.Choices = append(ballot.Choices, otherMajor)
+	choices = append(choices, otherMajor)
 
-	//fmt.Println("strategic", ballot)
-	return ballot
+	return choices
 }
 
 //IRVBallot has a slice with indices of candidates in preferential order
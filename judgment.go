@@ -0,0 +1,151 @@
+package main
+
+// MajorityJudgmentMethod : Each voter gives each candidate one of `grades` grades, from 0 (worst) to grades-1 (best).
+// The winner is the candidate with the highest median grade. Ties are broken by removing one median grade from each tied
+// candidate and comparing the new medians, repeating until the tie is broken.
+// Strategic voters give the top grade to their preferred major candidate and everyone they like at least as much, and the
+// bottom grade to everyone else.
+type MajorityJudgmentMethod struct {
+	grades int //number of grade levels
+}
+
+// NewMajorityJudgmentMethod is the "constructor" for MajorityJudgmentMethod. It requires the number of grade levels.
+func NewMajorityJudgmentMethod(grades int) MajorityJudgmentMethod {
+	return MajorityJudgmentMethod{grades}
+}
+
+// NewAdaptedMajorityJudgmentMethod is a convenience function to construct a MajorityJudgmentMethod and adapt it to the normal Method interface.
+func NewAdaptedMajorityJudgmentMethod(grades int) AdaptedMethod {
+	majorityJudgmentMethod := NewMajorityJudgmentMethod(grades)
+	return AdaptSimpleMethod(&majorityJudgmentMethod)
+}
+
+// FindWinner finds the index of the Majority Judgment winner of the provided Electorate.
+func (m *MajorityJudgmentMethod) FindWinner(electorate *Electorate) int {
+	counts := gradeCounts(electorate, m.grades)
+
+	winner := 0
+	for i := 1; i < len(counts); i++ {
+		if compareMajorityValues(counts[i], counts[winner], len(electorate.Voters)) > 0 {
+			winner = i
+		}
+	}
+
+	return winner
+}
+
+// UsualJudgmentMethod : Each voter grades each candidate exactly as in MajorityJudgmentMethod.
+// Candidates are ranked by median grade, and ties are broken by how much of the electorate sits above the median compared
+// to below it. Each candidate's score is m + (p-q)/(2(1-p-q)), where m is the median grade, p is the fraction of grades
+// above the median and q is the fraction below. The winner is the candidate with the highest score.
+type UsualJudgmentMethod struct {
+	grades int //number of grade levels
+}
+
+// NewUsualJudgmentMethod is the "constructor" for UsualJudgmentMethod. It requires the number of grade levels.
+func NewUsualJudgmentMethod(grades int) UsualJudgmentMethod {
+	return UsualJudgmentMethod{grades}
+}
+
+// NewAdaptedUsualJudgmentMethod is a convenience function to construct a UsualJudgmentMethod and adapt it to the normal Method interface.
+func NewAdaptedUsualJudgmentMethod(grades int) AdaptedMethod {
+	usualJudgmentMethod := NewUsualJudgmentMethod(grades)
+	return AdaptSimpleMethod(&usualJudgmentMethod)
+}
+
+// FindWinner finds the index of the Usual Judgment winner of the provided Electorate.
+func (m *UsualJudgmentMethod) FindWinner(electorate *Electorate) int {
+	counts := gradeCounts(electorate, m.grades)
+	numVoters := len(electorate.Voters)
+
+	winner := 0
+	winnerScore := 0.0
+
+	for i, c := range counts {
+		median := lowerMedian(c, numVoters)
+
+		above, below := 0, 0
+		for g, n := range c {
+			if g > median {
+				above += n
+			} else if g < median {
+				below += n
+			}
+		}
+
+		p := float64(above) / float64(numVoters)
+		q := float64(below) / float64(numVoters)
+
+		//at least one voter gave the median grade, so 1-p-q is never 0
+		score := float64(median) + (p-q)/(2*(1-p-q))
+
+		if i == 0 || score > winnerScore {
+			winner = i
+			winnerScore = score
+		}
+	}
+
+	return winner
+}
+
+// gradeCounts grades every candidate on each voter's ballot and counts how many times each candidate received each grade.
+// Element [c][g] is the number of voters giving candidate c grade g.
+// Ballots are built like ScoreMethod ballots with a range of 0 to grades-1.
+func gradeCounts(electorate *Electorate, grades int) [][]int {
+	grader := NewScoreMethod(0, grades-1)
+
+	counts := make([][]int, len(electorate.Candidates))
+	for i := range counts {
+		counts[i] = make([]int, grades)
+	}
+
+	for _, voter := range electorate.Voters {
+		favoriteMajor := findFavoriteMajor(voter.Utilities, electorate.Candidates)
+		threshold := voter.Utilities[favoriteMajor]
+		for c, grade := range grader.vote(&voter, threshold) {
+			counts[c][grade]++
+		}
+	}
+
+	return counts
+}
+
+// lowerMedian finds the median grade from counts of each grade, which total to numGrades.
+// When there is an even number of grades, the lower of the two middle grades is used.
+func lowerMedian(counts []int, numGrades int) int {
+	position := (numGrades - 1) / 2
+
+	seen := 0
+	for g, n := range counts {
+		seen += n
+		if seen > position {
+			return g
+		}
+	}
+
+	return 0
+}
+
+// compareMajorityValues compares the grades of two candidates under Majority Judgment, given the counts of each grade they
+// received from numGrades voters. It returns 1 if a ranks higher, -1 if b ranks higher and 0 if they are exactly tied.
+func compareMajorityValues(a, b []int, numGrades int) int {
+	//work on copies so that median grades can be removed one at a time
+	a = append([]int(nil), a...)
+	b = append([]int(nil), b...)
+
+	for n := numGrades; n > 0; n-- {
+		ma := lowerMedian(a, n)
+		mb := lowerMedian(b, n)
+
+		if ma > mb {
+			return 1
+		} else if mb > ma {
+			return -1
+		}
+
+		a[ma]--
+		b[mb]--
+	}
+
+	return 0
+}
@@ -0,0 +1,105 @@
+package main
+
+import "strings"
+
+// maxKemenyCandidates is the largest number of candidates KemenyYoungMethod will search exactly.
+// The search visits every subset of the candidates, so the work doubles with each candidate added.
+const maxKemenyCandidates = 10
+
+// KemenyYoungMethod : Each voter ranks every candidate, with ballots cast exactly as in IRVMethod.
+// The winner is the top of the consensus ranking: the ranking that agrees with the most pairwise preferences across all
+// ballots, which is the same as disagreeing with the fewest. A Condorcet winner is always first in this ranking.
+// The best ranking is found exactly with dynamic programming over subsets of candidates. Elections with more than
+// maxKemenyCandidates candidates are too big to search, so they fall back to the Ranked Pairs winner, which is usually
+// the same candidate.
+type KemenyYoungMethod struct {
+	candidates []Candidate //candidates from the most recent election, used to name the ranking
+	ranking    []int       //consensus ranking from the most recent election, nil if the fallback was used
+}
+
+// NewAdaptedKemenyYoungMethod is a convenience function to construct a KemenyYoungMethod and adapt it to the normal Method interface.
+func NewAdaptedKemenyYoungMethod() AdaptedMethod {
+	return AdaptSimpleMethod(&KemenyYoungMethod{})
+}
+
+// FindWinner finds the index of the Kemeny-Young winner of the provided Electorate.
+func (m *KemenyYoungMethod) FindWinner(electorate *Electorate) int {
+	m.candidates = electorate.Candidates
+
+	if len(electorate.Candidates) > maxKemenyCandidates {
+		m.ranking = nil
+		fallback := RankedPairsMethod{}
+		return fallback.FindWinner(electorate)
+	}
+
+	t := electorate.ballotTally()
+	m.ranking = kemenyRanking(&t)
+
+	return m.ranking[0]
+}
+
+// GetDetails lists the consensus ranking
+func (m *KemenyYoungMethod) GetDetails() string {
+	if m.ranking == nil {
+		return "too many candidates to search, used Ranked Pairs"
+	}
+
+	names := make([]string, len(m.ranking))
+	for i, c := range m.ranking {
+		names[i] = m.candidates[c].Name
+	}
+
+	return "ranking " + strings.Join(names, " > ")
+}
+
+// kemenyRanking finds the ranking of all candidates with the highest total agreement with the pairwise tally.
+// If more than one ranking is best, the search always settles on the same one.
+func kemenyRanking(t *PairwiseTally) []int {
+	n := t.size()
+	full := 1<<uint(n) - 1
+
+	//sets of candidates are bit masks of candidate indices
+	//best[s] is the highest agreement possible when the candidates in set s fill the top of the ranking
+	//last[s] is the candidate at the bottom of set s in that best ordering
+	best := make([]int, full+1)
+	last := make([]int, full+1)
+	for s := range best {
+		best[s] = -1
+	}
+	best[0] = 0
+
+	for s := 0; s < full; s++ {
+		if best[s] < 0 {
+			continue
+		}
+
+		for c := 0; c < n; c++ {
+			bit := 1 << uint(c)
+			if s&bit != 0 {
+				continue
+			}
+
+			//placing c below everyone in s agrees with each ballot that prefers a member of s to c
+			score := best[s]
+			for above := 0; above < n; above++ {
+				if s&(1<<uint(above)) != 0 {
+					score += t.Wins[above][c]
+				}
+			}
+
+			if score > best[s|bit] {
+				best[s|bit] = score
+				last[s|bit] = c
+			}
+		}
+	}
+
+	//walk back from the full set to recover the ranking, bottom first
+	ranking := make([]int, n)
+	for s, i := full, n-1; s != 0; i-- {
+		ranking[i] = last[s]
+		s &^= 1 << uint(last[s])
+	}
+
+	return ranking
+}
@@ -1,164 +1,357 @@
 package main
 
 import (
+	"flag"
 	"fmt"
-	"math/rand"
-	"sync"
+	"os"
+	"text/tabwriter"
 	"time"
 )
 
 func main() {
 	start := time.Now()
 
-	//get user values from params.json
-	params := readParams()
+	//get user values from params.json and the command line
+	params, err := parseCommandLine(os.Args[1:], os.Stderr)
+	if err == flag.ErrHelp {
+		return
+	}
+	if err != nil {
+		fail(err)
+	}
+
+	//a seed of 0 asks for a fresh seed, which is printed so the run can be repeated
+	if params.Seed == 0 {
+		params.Seed = time.Now().UnixNano()
+	}
+
+	//check everything that could go wrong now rather than finding out after a long run
+	if err := params.Validate(); err != nil {
+		fail(err)
+	}
 
 	printParams(&params)
 
-	//random source that needs to be protected if used concurrently
-	r := rand.New(rand.NewSource(time.Now().UnixNano()))
-	var mu sync.Mutex
+	//output files are created now, so a bad path doesn't stop the program after a long run
+	var out *os.File
+	if params.Output != "" {
+		out, err = os.Create(params.Output)
+		if err != nil {
+			fail(err)
+		}
+	}
 
-	//create job channels and workers
-	startChan := make(chan bool, params.NumWorkers)
-	reviewChan := make(chan *Electorate, params.NumWorkers)
-	summaryChan := make(chan string, params.NumWorkers)
+	var log *ElectorateLog
+	if params.ElectorateLog != "" {
+		log, err = openElectorateLog(params.ElectorateLog)
+		if err != nil {
+			fail(err)
+		}
+	}
 
-	//start workers
-	for i := 0; i < params.NumWorkers; i++ {
-		go runWorker(&params, startChan, reviewChan, r, &mu)
+	var results []SweepResult
+	if len(params.Sweep) > 0 {
+		results = runSweep(&params, log)
+	} else {
+		summary := runStudy(&params, log)
+		printSummary(&params, &summary)
+		results = []SweepResult{{Summary: summary}}
 	}
 
-	go summaryWorker(&params, reviewChan, summaryChan)
+	//both files are finished before any error is reported, so a problem with one doesn't lose the other
+	failed := false
 
-	//starting the startWorker will begin the analysis
-	go startWorker(&params, startChan)
+	if out != nil {
+		if err := writeOutput(out, &params, results); err != nil {
+			report(err)
+			failed = true
+		}
+	}
 
-	//wait for results, which will be printed until a value comes over the doneChan
-	for line := range summaryChan {
-		fmt.Println(line)
+	if log != nil {
+		if err := log.Close(); err != nil {
+			report(err)
+			failed = true
+		}
 	}
 
-	close(startChan)
-	close(reviewChan)
+	if failed {
+		os.Exit(1)
+	}
 
 	elapsed := time.Since(start)
 	fmt.Printf("execution took %s \n", elapsed)
 }
 
-//prompts runWorker to start jobs at a pace determined by the size of the startChan and number of workers
-func startWorker(params *AppParams, startChan chan bool) {
-	//start a job for each electorate
-	for i := 0; i < params.NumElectorates; i++ {
-		startChan <- true
-	}
+//reports an error and exits with a non-zero exit code
+func fail(err error) {
+	report(err)
+	os.Exit(1)
 }
 
-//worker that creates and processes an electorate
-//electorates can be large, so don't allow too many to exist at once or you'll run out of memory
-func runWorker(params *AppParams, startChan <-chan bool, reviewChan chan<- *Electorate, r *rand.Rand, mu *sync.Mutex) {
+//reports an error without exiting
+func report(err error) {
+	fmt.Fprintln(os.Stderr, "electionsim:", err)
+}
 
-	for range startChan {
-		//create electorate
-		e := makeElectorate(params, r, mu)
+//runs every electorate in a study and returns the summary of the results
+//if log isn't nil, the result of each electorate is written to it
+func runStudy(params *AppParams, log *ElectorateLog) Summary {
+	//create job channels and workers
+	//stopChan is closed by the summaryWorker once it has all the results it needs
+	startChan := make(chan int, params.NumWorkers)
+	reviewChan := make(chan *Electorate, params.NumWorkers)
+	summaryChan := make(chan Summary)
+	stopChan := make(chan bool)
 
-		//create methods
-		pm := PluralityMethod{}
-		e.Methods["Plurality"] = &pm
-		pm.Create(&e)
+	//start workers
+	for i := 0; i < params.NumWorkers; i++ {
+		go runWorker(params, startChan, reviewChan, stopChan)
+	}
 
-		/* Alternative ApprovalMethod that uses ScoreMethod internally
-		nam := NewAdaptedScoreMethod(0, 1)
-		e.Methods["ApprovalNew"] = &nam
-		nam.Create(&e)
-		*/
+	go summaryWorker(params, reviewChan, summaryChan, stopChan, log)
 
-		am := ApprovalMethod{}
-		e.Methods["Approval"] = &am
-		am.Create(&e)
+	//starting the startWorker will begin the analysis
+	go startWorker(params, startChan, stopChan)
 
-		im := IRVMethod{}
-		e.Methods["IRV"] = &im
-		im.Create(&e)
+	//wait for the summary, which is sent once the study is complete
+	return <-summaryChan
+}
 
-		sm := NewAdaptedScoreMethod(0, 5)
-		e.Methods["Score"] = &sm
-		sm.Create(&e)
+//prompts runWorker to start jobs at a pace determined by the size of the startChan and number of workers
+func startWorker(params *AppParams, startChan chan<- int, stopChan <-chan bool) {
+	//closing the startChan lets the runWorkers know there are no more jobs
+	defer close(startChan)
+
+	//start a job for each electorate, identified by its index, until the summaryWorker says to stop
+	for i := 0; i < params.maxElectorates(); i++ {
+		select {
+		case startChan <- i:
+		case <-stopChan:
+			return
+		}
+	}
+}
+
+//worker that creates and processes an electorate
+//electorates can be large, so don't allow too many to exist at once or you'll run out of memory
+func runWorker(params *AppParams, startChan <-chan int, reviewChan chan<- *Electorate, stopChan <-chan bool) {
+
+	for i := range startChan {
+		//jobs still waiting in the startChan aren't needed once the summaryWorker has all of its results
+		select {
+		case <-stopChan:
+			return
+		default:
+		}
+
+		//create electorate
+		e := makeElectorate(params, i)
+
+		//create the selected methods
+		for _, nm := range params.roster {
+			m := nm.New()
+			e.Methods[nm.Name] = m
+			e.MethodNames = append(e.MethodNames, nm.Name)
+			m.Create(&e)
+		}
 
 		//run methods
-		for name := range e.Methods {
+		for _, name := range e.MethodNames {
 			e.Methods[name].Run()
 		}
 
-		//pass on to summaryWorker
-		reviewChan <- &e
+		//pass on to summaryWorker, unless it already has all the results it needs
+		select {
+		case reviewChan <- &e:
+		case <-stopChan:
+			return
+		}
 	}
 }
 
 //worker that collects results of all elections and compiles them into a summary
 //only 1 of these should be run at a time
-func summaryWorker(params *AppParams, reviewChan chan *Electorate, summaryChan chan string) {
+func summaryWorker(params *AppParams, reviewChan chan *Electorate, summaryChan chan Summary, stopChan chan bool, log *ElectorateLog) {
 	//create summary containers
-	efficiencies := make(map[string]float64)
-	numEfficiencies := 0.0
-	condorcets := make(map[string]float64)
-	numCondorcets := 0.0
+	stats := make(map[string]*MethodStats)
 	numCompleted := 0
 
+	//every method runs on the same electorates, so the difference between two methods is measured electorate by electorate
+	//this gives much tighter intervals than comparing the two methods' separate intervals
+	//keys are pairs of method names in the order the methods are run
+	diffs := make(map[[2]string]*RunningStat)
+	var names []string
+
+	//electorates finish out of order, so their reports are held until every earlier electorate is done
+	//adding results in electorate order keeps the summary identical no matter how many workers are used
+	pending := make(map[int]Report)
+	finished := false
+
+	//small studies print a report for every electorate, which also needs the electorate itself, also in electorate order
+	//when stopping adaptively, NumElectorates is only the minimum, so the most that could be run is checked instead
+	printing := params.maxElectorates() <= 10
+	toPrint := make(map[int]*Electorate)
+
 	//extract results from completed electorates
 	for e := range reviewChan {
+		if printing {
+			toPrint[e.Index] = e
+		}
+
+		pending[e.Index] = e.GetReport()
+
 		//add results to summaries
-		r := e.GetReport()
+		for {
+			r, ok := pending[numCompleted]
+			if !ok {
+				break
+			}
+			delete(pending, numCompleted)
 
-		if params.NumElectorates <= 10 {
-			printReport(e)
-		}
+			if printing {
+				printReport(toPrint[numCompleted], &r)
+				delete(toPrint, numCompleted)
+			}
 
-		numEfficiencies += 1.0
-		if e.CondorcetWinner > -1 {
-			numCondorcets += 1.0
-		}
+			log.write(&r)
 
-		for m, l := range r.Lines {
-			efficiencies[m] += l.Efficiency
-			if e.CondorcetWinner > -1 {
-				condorcets[m] += float64(l.Condorcet)
+			if names == nil {
+				names = r.Names
 			}
-		}
 
-		numCompleted++
+			for _, a := range names {
+				if stats[a] == nil {
+					stats[a] = &MethodStats{}
+				}
+				stats[a].add(r.Lines[a])
+			}
 
-		if numCompleted >= params.NumElectorates {
+			for i, a := range names {
+				for _, b := range names[i+1:] {
+					key := [2]string{a, b}
+					if diffs[key] == nil {
+						diffs[key] = &RunningStat{}
+					}
+					diffs[key].Add(r.Lines[a].Efficiency - r.Lines[b].Efficiency)
+				}
+			}
+
+			numCompleted++
+
+			//checking after each electorate, in order, means the stopping point doesn't depend on the workers
+			if isStudyComplete(params, numCompleted, stats) {
+				finished = true
+				break
+			}
+		}
+
+		if finished {
 			break
 		}
 	}
 
+	//let the startWorker and runWorkers know that no more electorates are needed
+	close(stopChan)
+
+	//pass the completed summary to the main process
+	summaryChan <- Summary{
+		NumElectorates: numCompleted,
+		TargetReached:  params.TargetStdErr > 0 && (numCompleted < params.maxElectorates() || isPrecise(params, stats)),
+		Names:          names,
+		Stats:          stats,
+		Diffs:          diffs,
+	}
+}
+
+//prints the summary of a single study
+func printSummary(params *AppParams, s *Summary) {
 	//table header
-	summaryChan <- fmt.Sprintf("----------")
-	summaryChan <- fmt.Sprintf("Method  Utility Efficiency  Condorcet Percent")
+	fmt.Println("----------")
+	fmt.Printf("Electorates completed: %v\n", s.NumElectorates)
+	if params.TargetStdErr > 0 {
+		if s.TargetReached {
+			fmt.Printf("Target standard error of %v reached\n", params.TargetStdErr)
+		} else {
+			fmt.Printf("Target standard error of %v NOT reached before the limit of %v electorates\n", params.TargetStdErr, params.maxElectorates())
+		}
+	}
+
+	w := newTableWriter()
+	fmt.Fprintln(w, "Method\tUtility Efficiency (SE) [95% CI]\tVSE (SE) [95% CI]\tCondorcet Percent (SE) [95% CI]")
+
+	for _, n := range s.Names {
+		m := s.Stats[n]
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", n,
+			formatStat(&m.Efficiency, 3), formatStat(&m.VSE, 3), formatStat(&m.Condorcet, 2))
+	}
+	w.Flush()
+
+	//paired differences in efficiency. An interval that doesn't include 0 is a real difference
+	fmt.Println("----------")
+	w = newTableWriter()
+	fmt.Fprintln(w, "Method A\tMethod B\tEfficiency A-B (SE) [95% CI]")
 
-	//complete summary and pass text lines to main process
-	for n, eff := range efficiencies {
-		eff = eff / numEfficiencies
-		con := condorcets[n] / numCondorcets
-		summaryChan <- fmt.Sprintf("%s     %.3f     %.2f", n, eff, con)
+	for i, a := range s.Names {
+		for _, b := range s.Names[i+1:] {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", a, b, formatStat(s.Diffs[[2]string{a, b}], 4))
+		}
 	}
+	w.Flush()
+}
 
-	//signal completion of study by closing the summaryChan
-	close(summaryChan)
+//creates a writer that lines up tab separated columns printed to standard output. Flush it after the last row
+func newTableWriter() *tabwriter.Writer {
+	return tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+}
+
+//true once enough electorates have been completed
+//with a TargetStdErr set, NumElectorates is the minimum and the study continues until every method is precise enough
+func isStudyComplete(params *AppParams, numCompleted int, stats map[string]*MethodStats) bool {
+	if numCompleted < params.NumElectorates {
+		return false
+	}
+
+	if numCompleted >= params.maxElectorates() {
+		return true
+	}
+
+	return isPrecise(params, stats)
+}
+
+//true if the standard error of every method's efficiency is below the target
+//the standard error can't be measured from fewer than 2 electorates, so they are never precise enough
+func isPrecise(params *AppParams, stats map[string]*MethodStats) bool {
+	for _, s := range stats {
+		if s.Efficiency.N < 2 || s.Efficiency.StdErr() >= params.TargetStdErr {
+			return false
+		}
+	}
+
+	return true
+}
+
+//formats the mean of a statistic with its standard error and 95% confidence interval, using the given number of decimals
+func formatStat(s *RunningStat, decimals int) string {
+	lo, hi := s.CI95()
+	return fmt.Sprintf("%.*f (%.*f) [%.*f, %.*f]", decimals, s.Mean, decimals+1, s.StdErr(), decimals, lo, decimals, hi)
 }
 
 //will print out summary information for a single electorate. Not useful for large studies
-func printReport(e *Electorate) {
-	r := e.GetReport()
+func printReport(e *Electorate, r *Report) {
 	fmt.Println("----------")
 	fmt.Printf("Voters: %v\n", r.NumVoters)
 	fmt.Printf("Candidates: %v\n", r.NumCandidates)
 	fmt.Printf("Utility: %s\n", candidateInfo(r.UtilityWinner, e))
 	fmt.Printf("Condorcet: %s\n", candidateInfo(r.CondorcetWinner, e))
-	for name, l := range r.Lines {
-		fmt.Printf("%s: %s, %.2f, %v \n", name, candidateInfo(l.Winner, e), l.Efficiency, l.Condorcet)
+
+	w := newTableWriter()
+	fmt.Fprintln(w, "Method\tWinner\tEfficiency\tVSE\tCondorcet\tDetails")
+	for _, name := range r.Names {
+		l := r.Lines[name]
+		fmt.Fprintf(w, "%s\t%s\t%.2f\t%.2f\t%v\t%s\n", name, candidateInfo(l.Winner, e), l.Efficiency, l.VSE, l.Condorcet, l.Details)
 	}
+	w.Flush()
 }
 
 //creates a string for a single candidate that's useful for examining small numbers of electorates
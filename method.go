@@ -8,6 +8,12 @@ type Method interface {
 	GetUtility() float64
 }
 
+// DetailedMethod is implemented by methods that have more to say about an election than the winner, such as the finalists
+// of a runoff. The details are shown in the per-electorate report.
+type DetailedMethod interface {
+	GetDetails() string
+}
+
 // SimpleMethod is a simpler method interface. It can be adapted into the normal Method using AdaptSimpleMethod().
 type SimpleMethod interface {
 	FindWinner(*Electorate) int
@@ -46,3 +52,12 @@ func (m *AdaptedMethod) GetWinner() int {
 func (m *AdaptedMethod) GetUtility() float64 {
 	return m.utility
 }
+
+// GetDetails passes on the details of the internal method, if it has any
+func (m *AdaptedMethod) GetDetails() string {
+	if d, ok := m.internalMethod.(DetailedMethod); ok {
+		return d.GetDetails()
+	}
+
+	return ""
+}
@@ -0,0 +1,60 @@
+package main
+
+import "math"
+
+// MinimaxMethod : Each voter ranks every candidate and every head-to-head matchup is counted.
+// Each candidate is judged by their worst pairwise defeat, and the winner is the candidate whose worst defeat is the smallest.
+// Defeats are measured either by the winning votes of the opponent or by the opponent's margin.
+// Strategic voters rank their preferred major candidate first and bury the other major candidate at the bottom.
+type MinimaxMethod struct {
+	margins bool //measure defeats by margin instead of by winning votes
+}
+
+// NewMinimaxMethod is the "constructor" for MinimaxMethod. Set margins to true to measure defeats by margin instead of by winning votes.
+func NewMinimaxMethod(margins bool) MinimaxMethod {
+	return MinimaxMethod{margins}
+}
+
+// NewAdaptedMinimaxMethod is a convenience function to construct a MinimaxMethod and adapt it to the normal Method interface.
+func NewAdaptedMinimaxMethod(margins bool) AdaptedMethod {
+	minimaxMethod := NewMinimaxMethod(margins)
+	return AdaptSimpleMethod(&minimaxMethod)
+}
+
+// FindWinner finds the index of the Minimax winner of the provided Electorate.
+func (m *MinimaxMethod) FindWinner(electorate *Electorate) int {
+	t := electorate.ballotTally()
+
+	//negate the worst defeats so the smallest one is found by findLargestIndex, which favors the lowest index in a tie
+	scores := make([]int, t.size())
+	for c := range scores {
+		scores[c] = -m.worstDefeat(&t, c)
+	}
+
+	return findLargestIndex(scores)
+}
+
+// worstDefeat finds the strength of the largest pairwise defeat suffered by candidate c
+func (m *MinimaxMethod) worstDefeat(t *PairwiseTally, c int) int {
+	if m.margins {
+		//margins are negative when c wins, so an undefeated candidate is measured by their narrowest victory
+		worst := math.MinInt32
+		for j := 0; j < t.size(); j++ {
+			if j != c && t.margin(j, c) > worst {
+				worst = t.margin(j, c)
+			}
+		}
+
+		return worst
+	}
+
+	//with winning votes only actual defeats count
+	worst := 0
+	for j := 0; j < t.size(); j++ {
+		if j != c && t.beats(j, c) && t.Wins[j][c] > worst {
+			worst = t.Wins[j][c]
+		}
+	}
+
+	return worst
+}
@@ -0,0 +1,53 @@
+package main
+
+// NansonMethod : Each voter ranks every candidate, with ballots cast exactly as in IRVMethod.
+// Borda scores are calculated and every candidate with a below average score is eliminated. Borda scores are then
+// recalculated among the remaining candidates, and elimination continues until one candidate remains.
+// If all remaining candidates have the same score, the one with the lowest index wins.
+type NansonMethod struct{}
+
+// NewAdaptedNansonMethod is a convenience function to construct a NansonMethod and adapt it to the normal Method interface.
+func NewAdaptedNansonMethod() AdaptedMethod {
+	return AdaptSimpleMethod(&NansonMethod{})
+}
+
+// FindWinner finds the index of the Nanson winner of the provided Electorate.
+func (m *NansonMethod) FindWinner(electorate *Electorate) int {
+	ballots := rankedBallots(electorate)
+	remaining := allCandidates(len(electorate.Candidates))
+
+	for {
+		scores := bordaScores(ballots, remaining)
+
+		total := 0
+		numRemaining := 0
+		for c := range scores {
+			if remaining[c] {
+				total += scores[c]
+				numRemaining++
+			}
+		}
+
+		//compare score*n with the total rather than score with the average to avoid rounding
+		eliminated := 0
+		for c := range scores {
+			if remaining[c] && scores[c]*numRemaining < total {
+				remaining[c] = false
+				eliminated++
+			}
+		}
+
+		//stop when one candidate is left, or when nobody is below average because all scores are equal
+		if eliminated == 0 || numRemaining-eliminated == 1 {
+			break
+		}
+	}
+
+	for c := range remaining {
+		if remaining[c] {
+			return c
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,200 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// OutputFile is the machine-readable form of a run, written as JSON
+type OutputFile struct {
+	Seed    int64          //master seed of the run, repeated here for convenience
+	Params  *AppParams     //every parameter of the run
+	Results []OutputResult //one result for a single study, or one for each point of a sweep in the order they were run
+}
+
+// OutputResult is the summary of one study
+type OutputResult struct {
+	Point         map[string]float64 //values of the swept fields at this point, empty when there's no sweep
+	Electorates   int                //number of electorates completed
+	TargetReached bool               //whether TargetStdErr was reached, when stopping adaptively
+	Methods       []OutputMethod     //results for each method, in the order they are run
+	Differences   []OutputDifference //paired differences in efficiency between every pair of methods
+}
+
+// OutputMethod is the result of a single method in one study
+type OutputMethod struct {
+	Name       string
+	Efficiency OutputStat
+	VSE        OutputStat
+	Condorcet  OutputStat
+}
+
+// OutputDifference is the paired difference in efficiency between two methods, A minus B
+type OutputDifference struct {
+	A          string
+	B          string
+	Efficiency OutputStat
+}
+
+// OutputStat is a mean with its standard error and 95% confidence interval
+type OutputStat struct {
+	N      int //number of electorates included
+	Mean   float64
+	StdErr float64
+	Low    float64 //lower bound of the 95% confidence interval
+	High   float64 //upper bound of the 95% confidence interval
+}
+
+// columns of the CSV output that follow the swept fields
+var csvColumns = []string{"Electorates", "Method",
+	"Efficiency", "EfficiencySE", "EfficiencyLow", "EfficiencyHigh",
+	"VSE", "VSESE", "VSELow", "VSEHigh",
+	"Condorcet", "CondorcetSE", "CondorcetLow", "CondorcetHigh", "CondorcetElectorates"}
+
+// outputFormat decides the format of the output file from its extension, either "csv" or "json"
+func outputFormat(path string) (string, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return "csv", nil
+	case ".json":
+		return "json", nil
+	}
+
+	return "", fmt.Errorf("output file %q must end in .csv or .json", path)
+}
+
+// writeOutput writes the results of a study or sweep to f, the file created for params.Output, in the format given by
+// its extension, and closes it. The file is created before the study starts so that a bad path is found right away
+func writeOutput(f *os.File, params *AppParams, results []SweepResult) error {
+	format, err := outputFormat(params.Output)
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	out := makeOutputFile(params, results)
+
+	if format == "json" {
+		enc := json.NewEncoder(f)
+		enc.SetIndent("", "\t")
+		err = enc.Encode(out)
+	} else {
+		err = writeCSV(f, params, &out)
+	}
+
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// makeOutputFile converts study summaries to their output form
+func makeOutputFile(params *AppParams, results []SweepResult) OutputFile {
+	out := OutputFile{
+		Seed:    params.Seed,
+		Params:  params,
+		Results: make([]OutputResult, len(results)),
+	}
+
+	for i, r := range results {
+		s := &r.Summary
+		or := OutputResult{
+			Point:         make(map[string]float64),
+			Electorates:   s.NumElectorates,
+			TargetReached: s.TargetReached,
+			Methods:       make([]OutputMethod, len(s.Names)),
+			Differences:   make([]OutputDifference, 0),
+		}
+
+		for j, v := range r.Point {
+			or.Point[params.Sweep[j].Field] = v
+		}
+
+		for j, n := range s.Names {
+			m := s.Stats[n]
+			or.Methods[j] = OutputMethod{
+				Name:       n,
+				Efficiency: makeOutputStat(&m.Efficiency),
+				VSE:        makeOutputStat(&m.VSE),
+				Condorcet:  makeOutputStat(&m.Condorcet),
+			}
+		}
+
+		for j, a := range s.Names {
+			for _, b := range s.Names[j+1:] {
+				or.Differences = append(or.Differences, OutputDifference{
+					A:          a,
+					B:          b,
+					Efficiency: makeOutputStat(s.Diffs[[2]string{a, b}]),
+				})
+			}
+		}
+
+		out.Results[i] = or
+	}
+
+	return out
+}
+
+func makeOutputStat(s *RunningStat) OutputStat {
+	lo, hi := s.CI95()
+	return OutputStat{N: s.N, Mean: s.Mean, StdErr: s.StdErr(), Low: lo, High: hi}
+}
+
+// writeCSV writes one row for each method in each result. The parameters and seed are written first as comment lines
+// starting with #, which most tools can skip (pandas: comment="#", R: comment.char="#")
+func writeCSV(f *os.File, params *AppParams, out *OutputFile) error {
+	rawParams, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(f, "# Seed: %d\n", params.Seed)
+	fmt.Fprintf(f, "# Params: %s\n", rawParams)
+
+	w := csv.NewWriter(f)
+
+	header := make([]string, 0)
+	for _, sp := range params.Sweep {
+		header = append(header, sp.Field)
+	}
+	header = append(header, csvColumns...)
+
+	if err := w.Write(header); err != nil {
+		return err
+	}
+
+	for _, r := range out.Results {
+		for _, m := range r.Methods {
+			row := make([]string, 0, len(header))
+			for _, sp := range params.Sweep {
+				row = append(row, formatFloat(r.Point[sp.Field]))
+			}
+
+			row = append(row, strconv.Itoa(r.Electorates), m.Name)
+			for _, s := range []OutputStat{m.Efficiency, m.VSE, m.Condorcet} {
+				row = append(row, formatFloat(s.Mean), formatFloat(s.StdErr), formatFloat(s.Low), formatFloat(s.High))
+			}
+			row = append(row, strconv.Itoa(m.Condorcet.N))
+
+			if err := w.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
+
+// formatFloat writes a float with as many digits as needed to read it back exactly
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
@@ -4,43 +4,223 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"strings"
+	"unicode"
 )
 
 //AppParams holds all run parameters specified in params.json
 type AppParams struct {
-	NumElectorates     int      //the number of unique Electorates to generate and test
-	MinVoters          int      //lower limit of randomly chosen size of electorate
-	MaxVoters          int      //upper limit of randomly chosen size of electorate
-	StrategicVoters    float64  //chance that a voter is "strategic"
-	MinCandidates      int      //lower limit of randomly chosen number of candidates
-	MaxCandidates      int      //upper limit of randomly chosen number of candidates
-	NumMajorCandidates int      //the number of candidates representing "major parties". This should be either 0 or 2
-	NumAxes            int      //the number of ideological axis that voters and candidates should align to
-	Names              []string //list of all possible names for candidates. Must be at least as long as MaxCandidates
-	NumWorkers         int      //number of concurrent workers to spawn for processing elections
-}
-
-func readParams() AppParams {
-	//load public values stored in params.json
-	raw, err := ioutil.ReadFile("params.json")
+	NumElectorates     int          //the number of unique Electorates to generate and test
+	MinVoters          int          //lower limit of randomly chosen size of electorate
+	MaxVoters          int          //upper limit of randomly chosen size of electorate
+	StrategicVoters    float64      //chance that a voter is "strategic"
+	MinCandidates      int          //lower limit of randomly chosen number of candidates
+	MaxCandidates      int          //upper limit of randomly chosen number of candidates
+	NumMajorCandidates int          //the number of candidates representing "major parties". This should be either 0 or 2
+	NumAxes            int          //the number of ideological axis that voters and candidates should align to
+	Names              []string     //list of all possible names for candidates. Must be at least as long as MaxCandidates
+	NumWorkers         int          //number of concurrent workers to spawn for processing elections
+	Seed               int64        //master seed that every electorate's random numbers are derived from. 0 picks a new seed
+	TargetStdErr       float64      //if above 0, keep adding electorates until every method's efficiency has a standard error below this
+	MaxElectorates     int          //the most electorates to run while trying to reach TargetStdErr
+	Sweep              []SweepParam //fields to vary in a parameter sweep. The full study is run for every combination of values
+	Output             string       //file to write the results to, as CSV or JSON depending on the extension. Empty for none
+	ElectorateLog      string       //file to write every electorate's results to as JSON lines. Empty for none
+	Methods            []MethodSpec //methods to run and their options, in order. Empty runs every method with its default options
+
+	roster []namedMethod //the methods built from Methods by Validate
+}
+
+//loads the parameters stored in the json file at path, usually params.json
+func readParams(path string) (AppParams, error) {
+	var params AppParams
+
+	raw, err := ioutil.ReadFile(path)
 	if err != nil {
-		panic(err)
+		return params, err
 	}
 
-	var params AppParams
 	err = json.Unmarshal(raw, &params)
 	if err != nil {
-		panic(err)
+		return params, fmt.Errorf("%s: %v", path, err)
 	}
 
-	return params
+	return params, nil
+}
+
+//ValidationError lists every problem found with a set of parameters
+type ValidationError struct {
+	Problems []string
+}
+
+func (v *ValidationError) Error() string {
+	return "invalid parameters:\n  " + strings.Join(v.Problems, "\n  ")
+}
+
+//Validate checks the parameters and reports every problem at once, so they can all be fixed before any work starts
+//each point of a sweep is checked as well, since sweeping a field can break rules that involve other fields
+//it also builds the roster of methods to run, which is only usable if no problems were found
+func (params *AppParams) Validate() error {
+	roster, problems := buildRoster(params.Methods)
+	params.roster = roster
+
+	if params.Output != "" {
+		if _, err := outputFormat(params.Output); err != nil {
+			problems = append(problems, err.Error())
+		}
+	}
+
+	if sweepProblems := checkSweep(params); len(sweepProblems) > 0 {
+		//the sweep can't be run, but problems with the fields it doesn't change are still worth reporting now
+		problems = append(problems, sweepProblems...)
+		for _, problem := range params.problems() {
+			if !mentionsSweptField(problem, params.Sweep) {
+				problems = append(problems, problem)
+			}
+		}
+		return &ValidationError{Problems: problems}
+	}
+
+	//the swept fields are replaced at every point, so only the points themselves are checked
+	//problems found at every point are reported once, the rest are reported along with the points that have them
+	points, err := sweepPoints(params.Sweep)
+	if err != nil {
+		return &ValidationError{Problems: append(problems, err.Error())}
+	}
+	if len(points) == 0 {
+		return &ValidationError{Problems: append(problems, "sweep has no points to run")}
+	}
+	pointProblems := make([][]string, len(points))
+	count := make(map[string]int)
+
+	for i, point := range points {
+		p := *params
+		for j, sp := range params.Sweep {
+			setParam(&p, sp.Field, point[j])
+		}
+
+		pointProblems[i] = p.problems()
+		for _, problem := range pointProblems[i] {
+			count[problem]++
+		}
+	}
+
+	for _, problem := range pointProblems[0] {
+		if count[problem] == len(points) {
+			problems = append(problems, problem)
+		}
+	}
+
+	for i, point := range points {
+		for _, problem := range pointProblems[i] {
+			if count[problem] < len(points) {
+				problems = append(problems, fmt.Sprintf("at sweep point %s: %s", describePoint(params.Sweep, point), problem))
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+
+	return nil
+}
+
+//true if a problem names any of the swept fields, whose values in the file are replaced at every point anyway
+func mentionsSweptField(problem string, sweep []SweepParam) bool {
+	for _, word := range strings.FieldsFunc(problem, func(r rune) bool { return !unicode.IsLetter(r) }) {
+		for _, sp := range sweep {
+			if word == sp.Field {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+//lists the problems with the values of the simulation parameters
+func (params *AppParams) problems() []string {
+	problems := make([]string, 0)
+	add := func(format string, a ...interface{}) {
+		problems = append(problems, fmt.Sprintf(format, a...))
+	}
+
+	if params.NumElectorates < 1 {
+		add("NumElectorates must be at least 1, not %v", params.NumElectorates)
+	}
+	if params.MinVoters < 1 {
+		add("MinVoters must be at least 1, not %v", params.MinVoters)
+	}
+	if params.MaxVoters < params.MinVoters {
+		add("MaxVoters (%v) must not be less than MinVoters (%v)", params.MaxVoters, params.MinVoters)
+	}
+	if params.StrategicVoters < 0 || params.StrategicVoters > 1 {
+		add("StrategicVoters must be between 0.0 and 1.0, not %v", params.StrategicVoters)
+	}
+	if params.MinCandidates < 3 {
+		add("MinCandidates must be at least 3, not %v", params.MinCandidates)
+	}
+	if params.MaxCandidates < params.MinCandidates {
+		add("MaxCandidates (%v) must not be less than MinCandidates (%v)", params.MaxCandidates, params.MinCandidates)
+	}
+	if params.NumMajorCandidates != 0 && params.NumMajorCandidates != 2 {
+		add("NumMajorCandidates must be 0 or 2, not %v", params.NumMajorCandidates)
+	}
+	if params.NumMajorCandidates == 0 && params.StrategicVoters != 0 {
+		add("StrategicVoters must be 0 when NumMajorCandidates is 0, not %v", params.StrategicVoters)
+	}
+	if params.NumAxes < 1 {
+		add("NumAxes must be at least 1, not %v", params.NumAxes)
+	}
+	if len(params.Names) < params.MaxCandidates {
+		add("Names has %v names but must have at least MaxCandidates (%v)", len(params.Names), params.MaxCandidates)
+	}
+	if params.NumWorkers < 1 {
+		add("NumWorkers must be at least 1, not %v", params.NumWorkers)
+	}
+	if params.TargetStdErr < 0 {
+		add("TargetStdErr must not be negative, not %v", params.TargetStdErr)
+	}
+	if params.TargetStdErr > 0 && params.MaxElectorates < params.NumElectorates {
+		add("MaxElectorates (%v) must not be less than NumElectorates (%v) when TargetStdErr is set", params.MaxElectorates, params.NumElectorates)
+	}
+
+	return problems
+}
+
+//the number of electorates that may be started, which is more than NumElectorates when stopping adaptively
+func (params *AppParams) maxElectorates() int {
+	if params.TargetStdErr > 0 && params.MaxElectorates > params.NumElectorates {
+		return params.MaxElectorates
+	}
+
+	return params.NumElectorates
 }
 
 func printParams(params *AppParams) {
-	fmt.Println("Electorates:", params.NumElectorates)
+	if params.TargetStdErr > 0 {
+		fmt.Println("Electorates:", params.NumElectorates, "to", params.maxElectorates(), "until standard error is below", params.TargetStdErr)
+	} else {
+		fmt.Println("Electorates:", params.NumElectorates)
+	}
 	fmt.Println("Voters:", params.MinVoters, "to", params.MaxVoters)
 	fmt.Println("Strategic Voters:", params.StrategicVoters)
 	fmt.Println("Candidates:", params.MinCandidates, "to", params.MaxCandidates)
 	fmt.Println("Axes:", params.NumAxes)
 	fmt.Println(params.NumWorkers, "workers")
+	fmt.Println("Seed:", params.Seed)
+	for _, sp := range params.Sweep {
+		values, _ := sp.values() //already checked by Validate
+		fmt.Println("Sweep:", sp.Field, values)
+	}
+	if params.Output != "" {
+		fmt.Println("Output:", params.Output)
+	}
+	if params.ElectorateLog != "" {
+		fmt.Println("Electorate log:", params.ElectorateLog)
+	}
+	for i := range params.Methods {
+		fmt.Println("Method:", &params.Methods[i])
+	}
 }
@@ -8,6 +8,7 @@ type PluralityMethod struct {
 	Winner     int               //index of winning candidate
 	Ballots    []PluralityBallot //slice containing all ballots
 	Utility    float64           //average utility per voter achieved by winning candidate
+	TieBreak   TieBreak          //rule for choosing between candidates with the most votes
 }
 
 //Create creates the struct members needed to run the election
@@ -44,14 +45,7 @@ func (m *PluralityMethod) Run() {
 		votes[b.Choice]++
 	}
 
-	winningVotes := 0
-
-	for i := range votes {
-		if votes[i] > winningVotes {
-			winningVotes = votes[i]
-			m.Winner = i
-		}
-	}
+	m.Winner = m.TieBreak.pickLargest(toFloats(votes), m.Electorate)
 
 	m.calcUtility()
 
@@ -0,0 +1,66 @@
+package main
+
+import "math"
+
+// QuadraticMethod : Each voter has the same budget of credits to buy votes for candidates, and casting n votes for a
+// candidate costs n squared credits. The winner is the candidate with the most votes.
+// Honest voters spend their whole budget with votes in proportion to how much they like each candidate, on a scale where
+// their least favorite candidate is 0 and their favorite is 1. Strategic voters spend every credit on their preferred
+// major candidate.
+type QuadraticMethod struct {
+	credits  float64  //number of credits each voter spends
+	tieBreak TieBreak //rule for choosing between candidates with the most votes
+}
+
+// NewQuadraticMethod is the "constructor" for QuadraticMethod. It requires the number of credits each voter spends.
+func NewQuadraticMethod(credits float64) QuadraticMethod {
+	return QuadraticMethod{credits: credits}
+}
+
+// NewAdaptedQuadraticMethod is a convenience function to construct a QuadraticMethod and adapt it to the normal Method interface.
+func NewAdaptedQuadraticMethod(credits float64) AdaptedMethod {
+	quadraticMethod := NewQuadraticMethod(credits)
+	return AdaptSimpleMethod(&quadraticMethod)
+}
+
+// FindWinner finds the index of the quadratic voting winner of the provided Electorate.
+func (m *QuadraticMethod) FindWinner(electorate *Electorate) int {
+	sums := make([]float64, len(electorate.Candidates))
+
+	for _, voter := range electorate.Voters {
+		for j, votes := range m.vote(&voter, electorate.Candidates) {
+			sums[j] += votes
+		}
+	}
+
+	return m.tieBreak.pickLargest(sums, electorate)
+}
+
+func (m *QuadraticMethod) vote(voter *Voter, candidates []Candidate) []float64 {
+	ballot := make([]float64, len(voter.Utilities))
+
+	if voter.Strategic {
+		ballot[findFavoriteMajor(voter.Utilities, candidates)] = math.Sqrt(m.credits)
+		return ballot
+	}
+
+	normalized := normalizeUtilities(voter.Utilities)
+
+	sumSquares := 0.0
+	for _, u := range normalized {
+		sumSquares += u * u
+	}
+
+	//a voter that likes every candidate equally has no reason to spend any credits
+	if sumSquares == 0 {
+		return ballot
+	}
+
+	//scale the votes so that their squares add up to exactly the budget
+	scale := math.Sqrt(m.credits / sumSquares)
+	for i, u := range normalized {
+		ballot[i] = u * scale
+	}
+
+	return ballot
+}
@@ -0,0 +1,122 @@
+package main
+
+import "sort"
+
+// RankedPairsMethod : Each voter ranks every candidate. Every pairwise victory is sorted from largest margin to smallest,
+// then locked in one at a time unless it would create a cycle with the victories already locked.
+// The winner is the candidate that no locked victory points to.
+// Strategic voters rank their preferred major candidate first and bury the other major candidate at the bottom.
+type RankedPairsMethod struct{}
+
+// NewAdaptedRankedPairsMethod is a convenience function to construct a RankedPairsMethod and adapt it to the normal Method interface.
+func NewAdaptedRankedPairsMethod() AdaptedMethod {
+	return AdaptSimpleMethod(&RankedPairsMethod{})
+}
+
+// pairwiseVictory is a single head-to-head result where Winner is preferred to Loser on more ballots than the reverse
+type pairwiseVictory struct {
+	Winner int //index of the preferred candidate
+	Loser  int //index of the other candidate
+	Votes  int //number of ballots preferring Winner to Loser
+	Margin int //Votes minus the number of ballots preferring Loser to Winner
+}
+
+// FindWinner finds the index of the Ranked Pairs winner of the provided Electorate.
+func (m *RankedPairsMethod) FindWinner(electorate *Electorate) int {
+	numCandidates := len(electorate.Candidates)
+	t := electorate.ballotTally()
+
+	//locked[i][j] is true when the victory of i over j has been locked in
+	locked := make([][]bool, numCandidates)
+	for i := range locked {
+		locked[i] = make([]bool, numCandidates)
+	}
+
+	for _, v := range sortedVictories(&t) {
+		if !reaches(locked, v.Loser, v.Winner) {
+			locked[v.Winner][v.Loser] = true
+		}
+	}
+
+	//the winner is the source of the locked graph
+	//if ties leave more than one source, the lowest index wins
+CandidateLoop:
+	for j := 0; j < numCandidates; j++ {
+		for i := 0; i < numCandidates; i++ {
+			if locked[i][j] {
+				continue CandidateLoop
+			}
+		}
+
+		return j
+	}
+
+	//the locked graph never has a cycle, so there is always a source and this is never reached
+	return 0
+}
+
+// sortedVictories lists every pairwise victory from strongest to weakest.
+// Equal margins are broken by more winning votes, then by the lower winner index, then by the lower loser index,
+// so the order (and the election result) is always the same for the same ballots.
+func sortedVictories(t *PairwiseTally) []pairwiseVictory {
+	victories := make([]pairwiseVictory, 0)
+
+	for i := 0; i < t.size(); i++ {
+		for j := 0; j < t.size(); j++ {
+			if i != j && t.beats(i, j) {
+				victories = append(victories, pairwiseVictory{
+					Winner: i,
+					Loser:  j,
+					Votes:  t.Wins[i][j],
+					Margin: t.margin(i, j),
+				})
+			}
+		}
+	}
+
+	sort.Slice(victories, func(a, b int) bool {
+		va, vb := victories[a], victories[b]
+		if va.Margin != vb.Margin {
+			return va.Margin > vb.Margin
+		}
+		if va.Votes != vb.Votes {
+			return va.Votes > vb.Votes
+		}
+		if va.Winner != vb.Winner {
+			return va.Winner < vb.Winner
+		}
+		return va.Loser < vb.Loser
+	})
+
+	return victories
+}
+
+// reaches is true if there is a path of locked victories from candidate "from" to candidate "to"
+func reaches(locked [][]bool, from, to int) bool {
+	if from == to {
+		return true
+	}
+
+	visited := make([]bool, len(locked))
+	stack := []int{from}
+	visited[from] = true
+
+	for len(stack) > 0 {
+		c := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		for next, isLocked := range locked[c] {
+			if !isLocked || visited[next] {
+				continue
+			}
+			if next == to {
+				return true
+			}
+
+			visited[next] = true
+			stack = append(stack, next)
+		}
+	}
+
+	return false
+}
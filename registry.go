@@ -0,0 +1,377 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// MethodSpec selects a method to run and the options to run it with. In params.json it is either the name of a
+// registered method, such as "IRV", or an object such as {"Name": "Score 0-10", "Method": "Score", "Options": {"Max": 10}}
+// so the same method can be run more than once with different options.
+type MethodSpec struct {
+	Name    string                 //name shown in reports. Must be unique. Defaults to Method
+	Method  string                 //name of the registered method. Defaults to Name
+	Options map[string]interface{} //options for the method, see methodRegistry for the ones each method takes
+}
+
+// UnmarshalJSON reads a MethodSpec from either a plain method name or a full object
+func (s *MethodSpec) UnmarshalJSON(raw []byte) error {
+	var name string
+	if err := json.Unmarshal(raw, &name); err == nil {
+		*s = MethodSpec{Name: name}
+		return nil
+	}
+
+	//a separate type keeps json.Unmarshal from calling this function again
+	type plain MethodSpec
+	return json.Unmarshal(raw, (*plain)(s))
+}
+
+// MarshalJSON writes a MethodSpec as a plain method name when that's all it has, matching how they are usually written
+func (s MethodSpec) MarshalJSON() ([]byte, error) {
+	if len(s.Options) == 0 && (s.Method == "" || s.Method == s.Name) {
+		return json.Marshal(s.Name)
+	}
+
+	type plain MethodSpec
+	return json.Marshal(plain(s))
+}
+
+// the name of the registered method this spec runs
+func (s *MethodSpec) method() string {
+	if s.Method == "" {
+		return s.Name
+	}
+
+	return s.Method
+}
+
+// the name this spec's results are reported under
+func (s *MethodSpec) name() string {
+	if s.Name == "" {
+		return s.Method
+	}
+
+	return s.Name
+}
+
+// describes a spec for printParams, such as "Score 0-10 (Score, Max=10, Min=0)"
+func (s *MethodSpec) String() string {
+	if len(s.Options) == 0 && s.method() == s.name() {
+		return s.name()
+	}
+
+	parts := []string{s.method()}
+	for _, key := range sortedKeys(s.Options) {
+		parts = append(parts, fmt.Sprintf("%s=%v", key, s.Options[key]))
+	}
+
+	return fmt.Sprintf("%s (%s)", s.name(), strings.Join(parts, ", "))
+}
+
+// methodOptions reads the options given to a method, using defaults for any that are missing
+// problems are collected rather than returned so every bad option can be reported at once
+type methodOptions struct {
+	name     string //name of the method the options are for, as it appears in reports
+	values   map[string]interface{}
+	used     map[string]bool
+	problems []string
+}
+
+func (o *methodOptions) addProblem(format string, a ...interface{}) {
+	o.problems = append(o.problems, fmt.Sprintf(format, a...))
+}
+
+// reads a numeric option. JSON numbers are always float64
+func (o *methodOptions) float(key string, def float64) float64 {
+	o.used[key] = true
+	v, ok := o.values[key]
+	if !ok {
+		return def
+	}
+
+	f, ok := v.(float64)
+	if !ok {
+		o.addProblem("option %s must be a number, not %v", key, v)
+		return def
+	}
+
+	return f
+}
+
+// reads a whole number option
+func (o *methodOptions) int(key string, def int) int {
+	f := o.float(key, float64(def))
+	if f != float64(int(f)) {
+		o.addProblem("option %s must be a whole number, not %v", key, f)
+		return def
+	}
+
+	return int(f)
+}
+
+// reads an option that must be one of the allowed strings. The first allowed string is the default
+func (o *methodOptions) choice(key string, allowed ...string) string {
+	o.used[key] = true
+	v, ok := o.values[key]
+	if !ok {
+		return allowed[0]
+	}
+
+	for _, a := range allowed {
+		if v == a {
+			return a
+		}
+	}
+
+	o.addProblem("option %s must be one of %q, not %v", key, allowed, v)
+	return allowed[0]
+}
+
+// reads the tie-break rule option shared by methods that pick the largest total
+func (o *methodOptions) tieBreak() TieBreak {
+	return newTieBreak(o.choice("TieBreak", tieBreakLowest, tieBreakRandom), o.name)
+}
+
+// reads the approval threshold policy option shared by the approval based methods
+func (o *methodOptions) threshold() string {
+	return o.choice("Threshold", thresholdFixed, thresholdMean, thresholdMidrange)
+}
+
+// reports any options the method didn't read, which are usually misspelled
+func (o *methodOptions) checkUnused() {
+	for _, key := range sortedKeys(o.values) {
+		if !o.used[key] {
+			o.addProblem("unknown option %s", key)
+		}
+	}
+}
+
+// MethodFactory reads a method's options and returns a function that creates new instances of the method
+type MethodFactory func(o *methodOptions) func() Method
+
+// registeredMethod pairs the name of a method with the factory that creates it
+type registeredMethod struct {
+	Name    string
+	Factory MethodFactory
+}
+
+// methodRegistry lists every method that can be run on an electorate, in the order they run by default
+var methodRegistry = []registeredMethod{
+	{"Plurality", func(o *methodOptions) func() Method {
+		tieBreak := o.tieBreak()
+		return func() Method { return &PluralityMethod{TieBreak: tieBreak} }
+	}},
+	/* Alternative ApprovalMethod that uses ScoreMethod internally
+	{"ApprovalNew", func(o *methodOptions) func() Method {
+		return func() Method { m := NewAdaptedScoreMethod(0, 1); return &m }
+	}},
+	*/
+	{"Approval", func(o *methodOptions) func() Method {
+		threshold := o.threshold()
+		tieBreak := o.tieBreak()
+		return func() Method { return &ApprovalMethod{Threshold: threshold, TieBreak: tieBreak} }
+	}},
+	{"Approval Runoff", func(o *methodOptions) func() Method {
+		threshold := o.threshold()
+		return func() Method { m := NewAdaptedApprovalRunoffMethod(threshold); return &m }
+	}},
+	{"3-2-1", func(o *methodOptions) func() Method {
+		threshold := o.threshold()
+		return func() Method { m := NewAdaptedThreeTwoOneMethod(threshold); return &m }
+	}},
+	{"IRV", noOptions(func() Method { return &IRVMethod{} })},
+	{"Score", func(o *methodOptions) func() Method {
+		min, max := o.int("Min", 0), o.int("Max", 5)
+		tieBreak := o.tieBreak()
+		if max <= min {
+			o.addProblem("option Max (%v) must be more than Min (%v)", max, min)
+		}
+		return func() Method {
+			s := NewScoreMethod(min, max)
+			s.tieBreak = tieBreak
+			m := AdaptSimpleMethod(&s)
+			return &m
+		}
+	}},
+	{"Cumulative", func(o *methodOptions) func() Method {
+		points := o.float("Points", 100)
+		tieBreak := o.tieBreak()
+		if points <= 0 {
+			o.addProblem("option Points must be more than 0, not %v", points)
+		}
+		return func() Method {
+			c := NewCumulativeMethod(points)
+			c.tieBreak = tieBreak
+			m := AdaptSimpleMethod(&c)
+			return &m
+		}
+	}},
+	{"Quadratic", func(o *methodOptions) func() Method {
+		credits := o.float("Credits", 100)
+		tieBreak := o.tieBreak()
+		if credits <= 0 {
+			o.addProblem("option Credits must be more than 0, not %v", credits)
+		}
+		return func() Method {
+			q := NewQuadraticMethod(credits)
+			q.tieBreak = tieBreak
+			m := AdaptSimpleMethod(&q)
+			return &m
+		}
+	}},
+	{"STAR", func(o *methodOptions) func() Method {
+		min, max := o.int("Min", 0), o.int("Max", 5)
+		if max <= min {
+			o.addProblem("option Max (%v) must be more than Min (%v)", max, min)
+		}
+		return func() Method { m := NewAdaptedSTARMethod(min, max); return &m }
+	}},
+	{"Majority Judgment", func(o *methodOptions) func() Method {
+		grades := o.grades()
+		return func() Method { m := NewAdaptedMajorityJudgmentMethod(grades); return &m }
+	}},
+	{"Usual Judgment", func(o *methodOptions) func() Method {
+		grades := o.grades()
+		return func() Method { m := NewAdaptedUsualJudgmentMethod(grades); return &m }
+	}},
+	{"Two Round", noOptions(func() Method { m := NewAdaptedTwoRoundMethod(); return &m })},
+	{"Contingent", noOptions(func() Method { m := NewAdaptedContingentMethod(); return &m })},
+	{"Smith//IRV", noOptions(func() Method { m := NewAdaptedSmithIRVMethod(); return &m })},
+	{"Tideman Alternative", noOptions(func() Method { m := NewAdaptedTidemanAlternativeMethod(); return &m })},
+	{"Benham", noOptions(func() Method { m := NewAdaptedBenhamMethod(); return &m })},
+	{"Baldwin", noOptions(func() Method { m := NewAdaptedBaldwinMethod(); return &m })},
+	{"Nanson", noOptions(func() Method { m := NewAdaptedNansonMethod(); return &m })},
+	{"Bucklin", noOptions(func() Method { m := NewAdaptedBucklinMethod(); return &m })},
+	{"Coombs", noOptions(func() Method { m := NewAdaptedCoombsMethod(); return &m })},
+	{"Borda", func(o *methodOptions) func() Method {
+		tieBreak := o.tieBreak()
+		return func() Method { m := NewAdaptedBordaMethod(tieBreak); return &m }
+	}},
+	{"Schulze", noOptions(func() Method { m := NewAdaptedSchulzeMethod(); return &m })},
+	{"Ranked Pairs", noOptions(func() Method { m := NewAdaptedRankedPairsMethod(); return &m })},
+	{"Kemeny-Young", noOptions(func() Method { m := NewAdaptedKemenyYoungMethod(); return &m })},
+	{"Minimax (WV)", noOptions(func() Method { m := NewAdaptedMinimaxMethod(false); return &m })},
+	{"Minimax (Margins)", noOptions(func() Method { m := NewAdaptedMinimaxMethod(true); return &m })},
+	{"Copeland", func(o *methodOptions) func() Method {
+		tiePoints := o.float("TiePoints", 0.5)
+		tieBreak := o.tieBreak()
+		if tiePoints < 0 || tiePoints > 1 {
+			o.addProblem("option TiePoints must be between 0.0 and 1.0, not %v", tiePoints)
+		}
+		return func() Method {
+			c := NewCopelandMethod(tiePoints)
+			c.tieBreak = tieBreak
+			m := AdaptSimpleMethod(&c)
+			return &m
+		}
+	}},
+
+	//baselines that show the range of possible results
+	{"Random Candidate", noOptions(func() Method { m := NewAdaptedRandomCandidateMethod(); return &m })},
+	{"Random Ballot", noOptions(func() Method { m := NewAdaptedRandomBallotMethod(); return &m })},
+	{"Utility Winner", noOptions(func() Method { m := NewAdaptedUtilityWinnerMethod(); return &m })},
+}
+
+// noOptions is the factory for methods that don't take any options
+func noOptions(new func() Method) MethodFactory {
+	return func(o *methodOptions) func() Method {
+		return new
+	}
+}
+
+// reads the number of grades used by the judgment methods
+func (o *methodOptions) grades() int {
+	grades := o.int("Grades", 6)
+	if grades < 2 {
+		o.addProblem("option Grades must be at least 2, not %v", grades)
+	}
+
+	return grades
+}
+
+// finds a method in the registry by name
+func findMethod(name string) (MethodFactory, bool) {
+	for _, rm := range methodRegistry {
+		if rm.Name == name {
+			return rm.Factory, true
+		}
+	}
+
+	return nil, false
+}
+
+// namedMethod pairs the name of a method, as it appears in reports, with a function that creates a new instance of it
+type namedMethod struct {
+	Name string
+	New  func() Method
+}
+
+// buildRoster turns the configured method specs into the methods to run on each electorate, along with every problem
+// found in them. With no specs, every registered method is run with its default options.
+func buildRoster(specs []MethodSpec) ([]namedMethod, []string) {
+	if len(specs) == 0 {
+		specs = make([]MethodSpec, len(methodRegistry))
+		for i, rm := range methodRegistry {
+			specs[i] = MethodSpec{Name: rm.Name}
+		}
+	}
+
+	roster := make([]namedMethod, 0, len(specs))
+	problems := make([]string, 0)
+	seen := make(map[string]bool)
+
+	for _, spec := range specs {
+		name := spec.name()
+		if name == "" {
+			problems = append(problems, "every method needs a Name or Method")
+			continue
+		}
+
+		if seen[name] {
+			problems = append(problems, fmt.Sprintf("method %q is listed more than once, give each a different Name", name))
+		}
+		seen[name] = true
+
+		factory, ok := findMethod(spec.method())
+		if !ok {
+			problems = append(problems, fmt.Sprintf("unknown method %q, choose from: %s", spec.method(), strings.Join(registeredNames(), ", ")))
+			continue
+		}
+
+		o := methodOptions{name: name, values: spec.Options, used: make(map[string]bool)}
+		new := factory(&o)
+		o.checkUnused()
+
+		for _, problem := range o.problems {
+			problems = append(problems, fmt.Sprintf("method %q: %s", name, problem))
+		}
+
+		roster = append(roster, namedMethod{name, new})
+	}
+
+	return roster, problems
+}
+
+// lists the names of every registered method in registration order
+func registeredNames() []string {
+	names := make([]string, len(methodRegistry))
+	for i, rm := range methodRegistry {
+		names[i] = rm.Name
+	}
+
+	return names
+}
+
+// lists the keys of a map of options in alphabetical order
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
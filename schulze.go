@@ -0,0 +1,77 @@
+package main
+
+// SchulzeMethod : Each voter ranks every candidate. The ballots are used to build the pairwise preference matrix,
+// and the strength of the strongest path (beatpath) between every pair of candidates is found.
+// The winner is a candidate whose strongest path to every other candidate is at least as strong as the path back.
+// If there is a Condorcet winner among the ballots, they are always the Schulze winner.
+// Strategic voters rank their preferred major candidate first and bury the other major candidate at the bottom.
+type SchulzeMethod struct{}
+
+// NewAdaptedSchulzeMethod is a convenience function to construct a SchulzeMethod and adapt it to the normal Method interface.
+func NewAdaptedSchulzeMethod() AdaptedMethod {
+	return AdaptSimpleMethod(&SchulzeMethod{})
+}
+
+// FindWinner finds the index of the Schulze winner of the provided Electorate.
+func (m *SchulzeMethod) FindWinner(electorate *Electorate) int {
+	numCandidates := len(electorate.Candidates)
+	t := electorate.ballotTally()
+	p := strongestPaths(t.Wins)
+
+	//the lowest indexed candidate that is unbeaten by strongest paths wins, so a tie always resolves the same way
+CandidateLoop:
+	for i := 0; i < numCandidates; i++ {
+		for j := 0; j < numCandidates; j++ {
+			if i != j && p[j][i] > p[i][j] {
+				continue CandidateLoop
+			}
+		}
+
+		return i
+	}
+
+	//there is always at least one unbeaten candidate, so this is never reached
+	return 0
+}
+
+// strongestPaths computes the strength of the strongest path from each candidate to each other candidate
+// the strength of a path is its weakest link, and only links that are pairwise wins count (winning votes)
+func strongestPaths(d [][]int) [][]int {
+	n := len(d)
+
+	p := make([][]int, n)
+	for i := range p {
+		p[i] = make([]int, n)
+		for j := range p[i] {
+			if i != j && d[i][j] > d[j][i] {
+				p[i][j] = d[i][j]
+			}
+		}
+	}
+
+	//widest path variant of Floyd-Warshall
+	for k := 0; k < n; k++ {
+		for i := 0; i < n; i++ {
+			if i == k {
+				continue
+			}
+
+			for j := 0; j < n; j++ {
+				if j == i || j == k {
+					continue
+				}
+
+				weakest := p[i][k]
+				if p[k][j] < weakest {
+					weakest = p[k][j]
+				}
+
+				if weakest > p[i][j] {
+					p[i][j] = weakest
+				}
+			}
+		}
+	}
+
+	return p
+}
@@ -5,13 +5,14 @@ import "math"
 // ScoreMethod : Each voter gives each candidate a score within some range specified by `min` and `max`.
 // The winner is the candidate with the most votes.
 type ScoreMethod struct {
-	min int
-	max int
+	min      int
+	max      int
+	tieBreak TieBreak //rule for choosing between candidates with the highest total
 }
 
 // NewScoreMethod is the "constructor" for ScoreMethod. It requires a minimum and maximum score to be specified.
 func NewScoreMethod(min, max int) ScoreMethod {
-	return ScoreMethod{min, max}
+	return ScoreMethod{min: min, max: max}
 }
 
 // NewAdaptedScoreMethod is a convinience function to construct a ScoreMethod and adapt it to the normal Method interface.
@@ -32,7 +33,7 @@ func (m *ScoreMethod) FindWinner(electorate *Electorate) int {
 		}
 	}
 
-	return findLargestIndex(sums)
+	return m.tieBreak.pickLargest(toFloats(sums), electorate)
 }
 
 func findLargestIndex(list []int) int {
@@ -0,0 +1,75 @@
+package main
+
+// STARMethod : Score Then Automatic Runoff. Each voter scores every candidate within the range specified by `min` and `max`,
+// exactly as in ScoreMethod. The two candidates with the highest score totals are finalists, and the finalist
+// scored higher on more ballots wins the automatic runoff.
+type STARMethod struct {
+	score      ScoreMethod //builds the ballots
+	candidates []Candidate //candidates from the most recent election, used to name the finalists
+	finalists  [2]int      //indices of the two highest scoring candidates from the most recent election
+	tally      [2]int      //number of ballots preferring each finalist in the runoff
+}
+
+// NewSTARMethod is the "constructor" for STARMethod. It requires a minimum and maximum score to be specified.
+func NewSTARMethod(min, max int) STARMethod {
+	return STARMethod{score: NewScoreMethod(min, max), finalists: [2]int{-1, -1}}
+}
+
+// NewAdaptedSTARMethod is a convenience function to construct a STARMethod and adapt it to the normal Method interface.
+func NewAdaptedSTARMethod(min, max int) AdaptedMethod {
+	starMethod := NewSTARMethod(min, max)
+	return AdaptSimpleMethod(&starMethod)
+}
+
+// FindWinner finds the index of the STAR winner of the provided Electorate.
+func (m *STARMethod) FindWinner(electorate *Electorate) int {
+	//the same ballots are used for both rounds, so keep them
+	ballots := make([][]int, len(electorate.Voters))
+	sums := make([]int, len(electorate.Candidates))
+
+	for i, voter := range electorate.Voters {
+		favoriteMajor := findFavoriteMajor(voter.Utilities, electorate.Candidates)
+		threshold := voter.Utilities[favoriteMajor]
+		ballots[i] = m.score.vote(&voter, threshold)
+
+		for j, score := range ballots[i] {
+			sums[j] += score
+		}
+	}
+
+	m.candidates = electorate.Candidates
+	m.finalists = findTopTwo(sums)
+
+	//automatic runoff: each ballot supports whichever finalist it scored higher
+	m.tally = [2]int{0, 0}
+	for _, ballot := range ballots {
+		a, b := ballot[m.finalists[0]], ballot[m.finalists[1]]
+		if a > b {
+			m.tally[0]++
+		} else if b > a {
+			m.tally[1]++
+		}
+	}
+
+	//a tied runoff goes to the finalist with the higher score total, which is the first finalist
+	return runoffWinner(m.finalists, m.tally)
+}
+
+// GetDetails describes the finalists and the runoff result
+func (m *STARMethod) GetDetails() string {
+	return describeRunoff(m.candidates, m.finalists, m.tally)
+}
+
+// findTopTwo returns the indices of the largest and second largest values in list, favoring lower indices in a tie
+func findTopTwo(list []int) [2]int {
+	first := findLargestIndex(list)
+
+	second := -1
+	for i, value := range list {
+		if i != first && (second == -1 || value > list[second]) {
+			second = i
+		}
+	}
+
+	return [2]int{first, second}
+}
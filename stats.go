@@ -0,0 +1,71 @@
+package main
+
+import "math"
+
+// z value for a two-sided 95% confidence interval from the normal distribution
+const z95 = 1.96
+
+// RunningStat tracks the mean and variance of a series of values as they are added, without storing the values.
+// It uses Welford's algorithm, which stays accurate even when the values are large or very close together
+type RunningStat struct {
+	N    int     //number of values added
+	Mean float64 //mean of the values added so far
+	m2   float64 //sum of squared differences from the mean
+}
+
+// Add includes a single value in the statistic
+func (s *RunningStat) Add(x float64) {
+	s.N++
+	delta := x - s.Mean
+	s.Mean += delta / float64(s.N)
+	s.m2 += delta * (x - s.Mean)
+}
+
+// Variance is the sample variance of the values added so far. It is 0 until there are at least 2 values
+func (s *RunningStat) Variance() float64 {
+	if s.N < 2 {
+		return 0
+	}
+
+	return s.m2 / float64(s.N-1)
+}
+
+// StdErr is the standard error of the mean
+func (s *RunningStat) StdErr() float64 {
+	if s.N < 1 {
+		return 0
+	}
+
+	return math.Sqrt(s.Variance() / float64(s.N))
+}
+
+// CI95 returns the lower and upper bounds of the 95% confidence interval for the mean
+func (s *RunningStat) CI95() (float64, float64) {
+	margin := z95 * s.StdErr()
+	return s.Mean - margin, s.Mean + margin
+}
+
+// MethodStats collects the results of a single method across every electorate in a study
+type MethodStats struct {
+	Efficiency RunningStat //utility efficiency of the winner
+	VSE        RunningStat //voter satisfaction efficiency of the winner
+	Condorcet  RunningStat //1 if the Condorcet winner was elected, 0 if not. Electorates without a Condorcet winner aren't counted
+}
+
+// adds one electorate's result for the method
+func (s *MethodStats) add(l ReportLine) {
+	s.Efficiency.Add(l.Efficiency)
+	s.VSE.Add(l.VSE)
+	if l.Condorcet > -1 {
+		s.Condorcet.Add(float64(l.Condorcet))
+	}
+}
+
+// Summary holds the results of a complete study
+type Summary struct {
+	NumElectorates int                        //number of electorates whose results were included
+	TargetReached  bool                       //whether every method reached TargetStdErr, when stopping adaptively
+	Names          []string                   //names of the methods in the order they are run
+	Stats          map[string]*MethodStats    //results for each method, name of method as key
+	Diffs          map[[2]string]*RunningStat //paired differences in efficiency, keyed by pairs of names in the order the methods are run
+}
@@ -0,0 +1,214 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+)
+
+// SweepParam is one AppParams field to vary in a parameter sweep, with the values it should take
+// values are either listed in Values, or counted from From to To (inclusive) in steps of Step
+type SweepParam struct {
+	Field  string    //name of the AppParams field, exactly as it appears in params.json
+	Values []float64 //every value to try. If empty, From, To and Step are used instead
+	From   float64   //first value to try
+	To     float64   //last value to try
+	Step   float64   //increment between values
+}
+
+// SweepPoint is a single combination of values from a parameter sweep, in the same order as AppParams.Sweep
+type SweepPoint []float64
+
+// SweepResult is the summary of the study run at one point of a parameter sweep
+type SweepResult struct {
+	Point   SweepPoint
+	Summary Summary
+}
+
+// lists every value the parameter should take, or an error if From, To and Step don't describe any values
+func (sp *SweepParam) values() ([]float64, error) {
+	if len(sp.Values) > 0 {
+		return sp.Values, nil
+	}
+
+	if sp.To < sp.From {
+		return nil, fmt.Errorf("sweep: %s has To (%v) less than From (%v)", sp.Field, sp.To, sp.From)
+	}
+
+	if sp.Step <= 0 {
+		if sp.From == sp.To {
+			return []float64{sp.From}, nil
+		}
+		return nil, fmt.Errorf("sweep: %s needs either Values or a Step above 0, not %v", sp.Field, sp.Step)
+	}
+
+	//the number of steps is rounded so that floating point error doesn't drop the last value
+	numSteps := int(math.Floor((sp.To-sp.From)/sp.Step + 1e-9))
+	values := make([]float64, numSteps+1)
+	for i := range values {
+		//rounding keeps values like 0.30000000000000004 tidy in the output
+		values[i] = math.Round((sp.From+float64(i)*sp.Step)*1e9) / 1e9
+	}
+
+	return values, nil
+}
+
+// lists every combination of values of the swept parameters. The last parameter changes fastest
+func sweepPoints(sweep []SweepParam) ([]SweepPoint, error) {
+	points := []SweepPoint{{}}
+
+	for i := range sweep {
+		values, err := sweep[i].values()
+		if err != nil {
+			return nil, err
+		}
+
+		next := make([]SweepPoint, 0)
+		for _, p := range points {
+			for _, v := range values {
+				point := append(SweepPoint{}, p...)
+				next = append(next, append(point, v))
+			}
+		}
+		points = next
+	}
+
+	return points, nil
+}
+
+// sets a numeric AppParams field by its name. Whole number fields can only be set to whole numbers
+func setParam(params *AppParams, field string, value float64) error {
+	f := reflect.ValueOf(params).Elem().FieldByName(field)
+
+	if !f.IsValid() {
+		return fmt.Errorf("sweep: AppParams has no field named %q", field)
+	}
+
+	switch f.Kind() {
+	case reflect.Int, reflect.Int64:
+		if value != math.Trunc(value) {
+			return fmt.Errorf("sweep: %s must be a whole number, not %v", field, value)
+		}
+		f.SetInt(int64(value))
+	case reflect.Float64:
+		f.SetFloat(value)
+	default:
+		return fmt.Errorf("sweep: field %q is not a number and can't be swept", field)
+	}
+
+	return nil
+}
+
+// checkSweep makes sure every swept field exists, can be swept, is only swept once and has values it can take,
+// before any work starts. It lists every problem it finds, so they can all be fixed at once
+func checkSweep(params *AppParams) []string {
+	problems := make([]string, 0)
+	seen := make(map[string]bool)
+	p := *params
+	for _, sp := range params.Sweep {
+		if seen[sp.Field] {
+			problems = append(problems, fmt.Sprintf("sweep: %s is listed more than once", sp.Field))
+			continue
+		}
+		seen[sp.Field] = true
+
+		if err := setParam(&p, sp.Field, 0); err != nil {
+			problems = append(problems, err.Error())
+			continue
+		}
+
+		values, err := sp.values()
+		if err != nil {
+			problems = append(problems, err.Error())
+			continue
+		}
+
+		//every value is tried, since whole number fields can't take fractions
+		for _, v := range values {
+			if err := setParam(&p, sp.Field, v); err != nil {
+				problems = append(problems, err.Error())
+				break
+			}
+		}
+	}
+
+	return problems
+}
+
+// runs a full study at every point of the parameter sweep, then prints a single table of all results
+// the electorates of every point are written to the same log, each with the values of the swept fields
+func runSweep(params *AppParams, log *ElectorateLog) []SweepResult {
+	//the sweep was checked by checkSweep, so this can't fail
+	points, err := sweepPoints(params.Sweep)
+	if err != nil {
+		panic(err)
+	}
+	results := make([]SweepResult, 0, len(points))
+
+	for i, point := range points {
+		//each point is a copy of the params with the swept fields changed
+		//the seed is left alone, so every point is run on electorates built from the same random numbers
+		p := *params
+		p.Sweep = nil
+		for j, sp := range params.Sweep {
+			//fields were checked by checkSweep, so this can't fail
+			if err := setParam(&p, sp.Field, point[j]); err != nil {
+				panic(err)
+			}
+		}
+
+		fmt.Printf("sweep point %v of %v: %s\n", i+1, len(points), describePoint(params.Sweep, point))
+
+		if log != nil {
+			log.point = make(map[string]float64)
+			for j, sp := range params.Sweep {
+				log.point[sp.Field] = point[j]
+			}
+		}
+
+		results = append(results, SweepResult{Point: point, Summary: runStudy(&p, log)})
+	}
+
+	printSweep(params, results)
+
+	return results
+}
+
+// names the field values of a sweep point, such as "StrategicVoters=0.2 NumAxes=3"
+func describePoint(sweep []SweepParam, point SweepPoint) string {
+	parts := make([]string, len(point))
+	for i, v := range point {
+		parts[i] = fmt.Sprintf("%s=%v", sweep[i].Field, v)
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// prints one row for every method at every point of the sweep
+func printSweep(params *AppParams, results []SweepResult) {
+	header := make([]string, 0)
+	for _, sp := range params.Sweep {
+		header = append(header, sp.Field)
+	}
+	header = append(header, "Electorates", "Method", "Utility Efficiency (SE)", "VSE (SE)", "Condorcet Percent (SE)")
+
+	fmt.Println("----------")
+	w := newTableWriter()
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+
+	for _, r := range results {
+		values := make([]string, len(r.Point))
+		for i, v := range r.Point {
+			values[i] = fmt.Sprintf("%v\t", v)
+		}
+		prefix := strings.Join(values, "")
+
+		for _, n := range r.Summary.Names {
+			m := r.Summary.Stats[n]
+			fmt.Fprintf(w, "%s%v\t%s\t%.3f (%.4f)\t%.3f (%.4f)\t%.2f (%.3f)\n", prefix, r.Summary.NumElectorates, n,
+				m.Efficiency.Mean, m.Efficiency.StdErr(), m.VSE.Mean, m.VSE.StdErr(), m.Condorcet.Mean, m.Condorcet.StdErr())
+		}
+	}
+	w.Flush()
+}
@@ -0,0 +1,115 @@
+package main
+
+import "sort"
+
+// grades available on a 3-2-1 ballot
+const (
+	gradeBad  = 0
+	gradeOK   = 1
+	gradeGood = 2
+)
+
+// ThreeTwoOneMethod : 3-2-1 voting. Each voter rates every candidate as good, ok or bad.
+// The 3 candidates with the most "good" ratings are semifinalists. Of those, the 2 with the fewest "bad" ratings are
+// finalists, and the winner is the finalist rated higher on more ballots.
+// Ratings start from the voter's approval ballot, cast exactly as in ApprovalMethod. Candidates that aren't approved are bad.
+// Approved candidates are good if the voter likes them at least halfway between their approval threshold and their
+// favorite, and ok otherwise. The approval threshold is chosen by the same policies as ApprovalMethod.
+type ThreeTwoOneMethod struct {
+	threshold  string      //approval threshold policy, as in ApprovalMethod
+	candidates []Candidate //candidates from the most recent election, used to name the finalists
+	finalists  [2]int      //indices of the two finalists
+	tally      [2]int      //number of ballots rating each finalist higher than the other
+}
+
+// NewAdaptedThreeTwoOneMethod is a convenience function to construct a ThreeTwoOneMethod and adapt it to the normal Method interface.
+// threshold is the approval threshold policy used by honest voters, as in ApprovalMethod.
+func NewAdaptedThreeTwoOneMethod(threshold string) AdaptedMethod {
+	return AdaptSimpleMethod(&ThreeTwoOneMethod{threshold: threshold, finalists: [2]int{-1, -1}})
+}
+
+// FindWinner finds the index of the 3-2-1 winner of the provided Electorate.
+func (m *ThreeTwoOneMethod) FindWinner(electorate *Electorate) int {
+	m.candidates = electorate.Candidates
+
+	numCandidates := len(electorate.Candidates)
+	ballots := make([][]int, len(electorate.Voters))
+	goods := make([]int, numCandidates)
+	bads := make([]int, numCandidates)
+
+	for i := range electorate.Voters {
+		ballots[i] = m.vote(electorate, &electorate.Voters[i])
+
+		for c, grade := range ballots[i] {
+			if grade == gradeGood {
+				goods[c]++
+			} else if grade == gradeBad {
+				bads[c]++
+			}
+		}
+	}
+
+	//semifinalists have the most good ratings, favoring lower indices in a tie
+	semifinalists := make([]int, numCandidates)
+	for i := range semifinalists {
+		semifinalists[i] = i
+	}
+	sort.SliceStable(semifinalists, func(a, b int) bool {
+		return goods[semifinalists[a]] > goods[semifinalists[b]]
+	})
+	if len(semifinalists) > 3 {
+		semifinalists = semifinalists[:3]
+	}
+
+	//finalists have the fewest bad ratings. The stable sort keeps the order of good ratings in a tie
+	sort.SliceStable(semifinalists, func(a, b int) bool {
+		return bads[semifinalists[a]] < bads[semifinalists[b]]
+	})
+	m.finalists = [2]int{semifinalists[0], semifinalists[1]}
+
+	m.tally = [2]int{0, 0}
+	for _, ballot := range ballots {
+		a, b := ballot[m.finalists[0]], ballot[m.finalists[1]]
+		if a > b {
+			m.tally[0]++
+		} else if b > a {
+			m.tally[1]++
+		}
+	}
+
+	return runoffWinner(m.finalists, m.tally)
+}
+
+// GetDetails describes the finalists and their head-to-head result
+func (m *ThreeTwoOneMethod) GetDetails() string {
+	return describeRunoff(m.candidates, m.finalists, m.tally)
+}
+
+// vote rates every candidate for a single voter, building on the voter's approval ballot
+func (m *ThreeTwoOneMethod) vote(electorate *Electorate, voter *Voter) []int {
+	am := ApprovalMethod{Electorate: electorate, Threshold: m.threshold}
+
+	var approvals ApprovalBallot
+	if voter.Strategic {
+		approvals = am.VoteStrategic(voter)
+	} else {
+		approvals = am.Vote(voter)
+	}
+
+	//approved candidates at least this good are rated good rather than ok
+	favorite := voter.Utilities[findFavorite(voter.Utilities)]
+	goodThreshold := (am.threshold(voter) + favorite) / 2
+
+	ballot := make([]int, len(voter.Utilities))
+	for i, approved := range approvals.Approvals {
+		if !approved {
+			ballot[i] = gradeBad
+		} else if voter.Utilities[i] >= goodThreshold {
+			ballot[i] = gradeGood
+		} else {
+			ballot[i] = gradeOK
+		}
+	}
+
+	return ballot
+}
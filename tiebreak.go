@@ -0,0 +1,63 @@
+package main
+
+import (
+	"hash/fnv"
+	"math/rand"
+)
+
+// rules for choosing between candidates tied for the largest total
+const (
+	tieBreakLowest = "lowest" //the candidate with the lowest index wins. This is the default
+	tieBreakRandom = "random" //a tied candidate is chosen at random, using the electorate's seed
+)
+
+// TieBreak chooses between candidates tied for the largest total
+type TieBreak struct {
+	Rule   string //tieBreakLowest or tieBreakRandom. Empty is tieBreakLowest
+	Stream int    //random number stream used by tieBreakRandom, see newTieBreak
+}
+
+// newTieBreak creates a TieBreak for the method with the given name, as it appears in reports
+// each method's stream comes from its Name rather than its position, so its tie-breaks don't depend on which other
+// methods are run or in what order
+func newTieBreak(rule, name string) TieBreak {
+	h := fnv.New32a()
+	h.Write([]byte(name))
+
+	return TieBreak{Rule: rule, Stream: int(h.Sum32())}
+}
+
+// pickLargest finds the index of the largest total, choosing among tied candidates by the tie-break rule
+func (t TieBreak) pickLargest(totals []float64, electorate *Electorate) int {
+	tied := []int{0}
+	for i := 1; i < len(totals); i++ {
+		if totals[i] > totals[tied[0]] {
+			tied = []int{i}
+		} else if totals[i] == totals[tied[0]] {
+			tied = append(tied, i)
+		}
+	}
+
+	if t.Rule == tieBreakRandom && len(tied) > 1 {
+		return tied[t.rand(electorate).Intn(len(tied))]
+	}
+
+	return tied[0]
+}
+
+// creates the random source for a random tie-break
+// tie-break streams are derived from their own seed so they can't collide with the numbered streams of other methods
+func (t TieBreak) rand(electorate *Electorate) *rand.Rand {
+	seed := electorateSeed(electorateSeed(electorate.Seed, tieBreakStream), t.Stream)
+	return rand.New(rand.NewSource(seed))
+}
+
+// toFloats copies integer totals so they can be passed to pickLargest
+func toFloats(list []int) []float64 {
+	floats := make([]float64, len(list))
+	for i, value := range list {
+		floats[i] = float64(value)
+	}
+
+	return floats
+}
@@ -0,0 +1,141 @@
+package main
+
+import "fmt"
+
+// TwoRoundMethod : A two round runoff. The first round is a Plurality election, with ballots cast exactly as in PluralityMethod.
+// A candidate with a majority of first round votes wins outright. Otherwise the top two candidates go to a second round,
+// where every voter honestly chooses whichever finalist they prefer.
+type TwoRoundMethod struct {
+	candidates []Candidate //candidates from the most recent election, used to name the finalists
+	finalists  [2]int      //indices of the top two candidates in the first round, -1 if there was no second round
+	tally      [2]int      //number of votes for each finalist in the second round
+}
+
+// NewAdaptedTwoRoundMethod is a convenience function to construct a TwoRoundMethod and adapt it to the normal Method interface.
+func NewAdaptedTwoRoundMethod() AdaptedMethod {
+	return AdaptSimpleMethod(&TwoRoundMethod{finalists: [2]int{-1, -1}})
+}
+
+// FindWinner finds the index of the two round winner of the provided Electorate.
+func (m *TwoRoundMethod) FindWinner(electorate *Electorate) int {
+	m.candidates = electorate.Candidates
+	m.finalists = [2]int{-1, -1}
+
+	//first round
+	pm := PluralityMethod{Electorate: electorate}
+	votes := make([]int, len(electorate.Candidates))
+
+	for i := range electorate.Voters {
+		if electorate.Voters[i].Strategic {
+			votes[pm.VoteStrategic(&electorate.Voters[i]).Choice]++
+		} else {
+			votes[pm.Vote(&electorate.Voters[i]).Choice]++
+		}
+	}
+
+	leaders := findTopTwo(votes)
+	if votes[leaders[0]] > len(electorate.Voters)/2 {
+		return leaders[0]
+	}
+
+	//second round
+	m.finalists = leaders
+	m.tally = honestRunoff(electorate, leaders)
+
+	return runoffWinner(m.finalists, m.tally)
+}
+
+// GetDetails describes the second round, if there was one
+func (m *TwoRoundMethod) GetDetails() string {
+	return describeRunoff(m.candidates, m.finalists, m.tally)
+}
+
+// ContingentMethod : The contingent vote, an instant version of the two round runoff. Each voter ranks every candidate,
+// with ballots cast exactly as in IRVMethod. A candidate with a majority of first choices wins outright. Otherwise every
+// candidate except the top two is eliminated at once, and each ballot counts for whichever finalist it ranks higher.
+type ContingentMethod struct {
+	candidates []Candidate //candidates from the most recent election, used to name the finalists
+	finalists  [2]int      //indices of the top two candidates by first choices, -1 if there was no runoff
+	tally      [2]int      //number of ballots ranking each finalist higher in the runoff
+}
+
+// NewAdaptedContingentMethod is a convenience function to construct a ContingentMethod and adapt it to the normal Method interface.
+func NewAdaptedContingentMethod() AdaptedMethod {
+	return AdaptSimpleMethod(&ContingentMethod{finalists: [2]int{-1, -1}})
+}
+
+// FindWinner finds the index of the contingent vote winner of the provided Electorate.
+func (m *ContingentMethod) FindWinner(electorate *Electorate) int {
+	m.candidates = electorate.Candidates
+	m.finalists = [2]int{-1, -1}
+
+	ballots := rankedBallots(electorate)
+
+	//first choices
+	votes := make([]int, len(electorate.Candidates))
+	for _, b := range ballots {
+		votes[b.Choices[0]]++
+	}
+
+	leaders := findTopTwo(votes)
+	if votes[leaders[0]] > len(ballots)/2 {
+		return leaders[0]
+	}
+
+	//runoff between the top two
+	m.finalists = leaders
+	m.tally = [2]int{0, 0}
+	for _, b := range ballots {
+		for _, c := range b.Choices {
+			if c == leaders[0] {
+				m.tally[0]++
+				break
+			} else if c == leaders[1] {
+				m.tally[1]++
+				break
+			}
+		}
+	}
+
+	return runoffWinner(m.finalists, m.tally)
+}
+
+// GetDetails describes the runoff, if there was one
+func (m *ContingentMethod) GetDetails() string {
+	return describeRunoff(m.candidates, m.finalists, m.tally)
+}
+
+// honestRunoff counts the voters honestly preferring each of the two finalists. Voters who like both equally don't vote.
+func honestRunoff(electorate *Electorate, finalists [2]int) [2]int {
+	tally := [2]int{0, 0}
+
+	for _, v := range electorate.Voters {
+		if v.Utilities[finalists[0]] > v.Utilities[finalists[1]] {
+			tally[0]++
+		} else if v.Utilities[finalists[1]] > v.Utilities[finalists[0]] {
+			tally[1]++
+		}
+	}
+
+	return tally
+}
+
+// runoffWinner picks the finalist with more votes. A tie goes to the first finalist, who led the earlier round.
+func runoffWinner(finalists, tally [2]int) int {
+	if tally[1] > tally[0] {
+		return finalists[1]
+	}
+
+	return finalists[0]
+}
+
+// describeRunoff names the two finalists of a runoff and the votes each received.
+// It returns an empty string if the runoff didn't happen.
+func describeRunoff(candidates []Candidate, finalists, tally [2]int) string {
+	if finalists[0] < 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("finalists %s and %s, runoff %v to %v",
+		candidates[finalists[0]].Name, candidates[finalists[1]].Name, tally[0], tally[1])
+}
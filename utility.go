@@ -12,6 +12,7 @@ func (e *Electorate) findUtilityWinner() {
 	var winnerUtil float64
 
 	var util float64
+	var totalUtil float64
 
 	for i := range e.Candidates {
 		util = 0.0
@@ -21,6 +22,7 @@ func (e *Electorate) findUtilityWinner() {
 		}
 
 		util = util / float64(numVoters)
+		totalUtil += util
 		if util > winnerUtil {
 			winner = i
 			winnerUtil = util
@@ -30,6 +32,7 @@ func (e *Electorate) findUtilityWinner() {
 
 	e.UtilityWinner = winner
 	e.MaxUtility = winnerUtil
+	e.RandomUtility = totalUtil / float64(len(e.Candidates))
 }
 
 //calculates the utilty for a voter from an elected candidate based on their distance in ideological space
@@ -99,3 +102,26 @@ func findOtherMajor(firstMajor int, candidates []Candidate) int {
 
 	return 0
 }
+
+//rescales a voter's utilities so that their least favorite candidate is 0.0 and their favorite is 1.0
+//if the voter likes every candidate equally, every value is 0.0
+func normalizeUtilities(utilities []float64) []float64 {
+	normalized := make([]float64, len(utilities))
+
+	smallest := utilities[0]
+	largest := utilities[0]
+	for _, u := range utilities {
+		smallest = math.Min(smallest, u)
+		largest = math.Max(largest, u)
+	}
+
+	if largest == smallest {
+		return normalized
+	}
+
+	for i, u := range utilities {
+		normalized[i] = (u - smallest) / (largest - smallest)
+	}
+
+	return normalized
+}